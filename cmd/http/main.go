@@ -1,36 +1,70 @@
-package http
+// Command http runs the HTTP API server.
+package main
 
 import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
 	"os"
 	"os/signal"
+	"syscall"
 
-	"github.com/fbriansyah/go-modular/config"
+	"github.com/fbriansyah/go-modular/constants"
+	authModule "github.com/fbriansyah/go-modular/internal/auth"
+	sharedModule "github.com/fbriansyah/go-modular/internal/shared"
 	userModule "github.com/fbriansyah/go-modular/internal/user"
-	"github.com/fbriansyah/go-modular/pkg/database"
-	"github.com/gofiber/fiber/v2"
 )
 
-func Main() {
-	conf, secret, err := config.LoadConfig("./config.yaml", "./secret.yaml")
-	if err != nil {
-		panic(err)
+func main() {
+	if err := run(); err != nil {
+		log.Printf("application stopped with error: %v", err)
+		os.Exit(1)
 	}
-	dbManager, err := database.NewManager(&conf.Database, &secret.Database, "./migrations")
-	if err != nil {
-		panic(err)
+}
+
+func run() error {
+	configPath := flag.String("config", "./config.yaml", "path to the config file")
+	secretPath := flag.String("secret", "./secret.yaml", "path to the secret file (optional)")
+	migrationsPath := flag.String("migrations", "./migrations", "path to the migrations directory")
+	runMigrations := flag.Bool("migrate", false, "apply pending migrations on startup")
+	addr := flag.String("addr", "", "address to listen on (overrides server.address)")
+	flag.Parse()
+
+	app := sharedModule.NewBootstrap(sharedModule.BootstrapOptions{
+		ConfigPath:     *configPath,
+		SecretPath:     *secretPath,
+		MigrationsPath: *migrationsPath,
+		RunMigrations:  *runMigrations,
+	})
+
+	if err := app.Register(
+		authModule.NewAuthModule(),
+		userModule.NewUserModule(),
+	); err != nil {
+		return fmt.Errorf("failed to register modules: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := app.Initialize(ctx); err != nil {
+		return errors.Join(fmt.Errorf("failed to initialize application: %w", err), shutdown(app))
 	}
-	httpApp := fiber.New()
-	userModel := userModule.NewUserModule(
-		conf,
-		userModule.WithDB(dbManager.DB),
-		userModule.WithHTTPApp(httpApp),
-	)
-	userModel.Run()
-	httpApp.Listen(":8080")
-
-	// setup graceful shutdown, listen for interrupt signal
-	signalChan := make(chan os.Signal, 1)
-	signal.Notify(signalChan, os.Interrupt)
-	<-signalChan
-	httpApp.Shutdown()
+
+	return app.Run(ctx, *addr)
+}
+
+// shutdown releases whatever a failed Initialize acquired
+func shutdown(app *sharedModule.Bootstrap) error {
+	timeout := constants.DefaultShutdownTimeout
+	if deps := app.Dependencies(); deps != nil {
+		timeout = deps.Config.Server.GetShutdownTimeout()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return app.Shutdown(ctx)
 }
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/fbriansyah/go-modular/config"
+	"github.com/fbriansyah/go-modular/pkg/database"
+)
+
+type command struct {
+	configPath     string
+	secretPath     string
+	migrationsPath string
+}
+
+// run executes the named command. Arguments are checked before connecting,
+// so usage errors are reported without a database.
+func (c *command) run(name string, args []string) error {
+	switch name {
+	case "up":
+		if err := expectArgs(args, 0); err != nil {
+			return err
+		}
+		return c.withManager(thenPrintStatus(func(mm *database.MigrationManager) error {
+			return mm.MigrateUp()
+		}))
+	case "down":
+		if err := expectArgs(args, 0); err != nil {
+			return err
+		}
+		return c.withManager(thenPrintStatus(func(mm *database.MigrationManager) error {
+			return mm.MigrateDown()
+		}))
+	case "steps":
+		if err := expectArgs(args, 1); err != nil {
+			return err
+		}
+		n, err := strconv.Atoi(args[0])
+		if err != nil || n == 0 {
+			return fmt.Errorf("%w: steps must be a non-zero integer, got %q", errUsage, args[0])
+		}
+		return c.withManager(thenPrintStatus(func(mm *database.MigrationManager) error {
+			return mm.MigrateSteps(n)
+		}))
+	case "to":
+		if err := expectArgs(args, 1); err != nil {
+			return err
+		}
+		version, err := strconv.ParseUint(args[0], 10, 32)
+		if err != nil {
+			return fmt.Errorf("%w: version must be a non-negative integer, got %q", errUsage, args[0])
+		}
+		return c.withManager(thenPrintStatus(func(mm *database.MigrationManager) error {
+			return mm.MigrateTo(uint(version))
+		}))
+	case "force":
+		if err := expectArgs(args, 1); err != nil {
+			return err
+		}
+		version, err := strconv.Atoi(args[0])
+		if err != nil || version < -1 {
+			return fmt.Errorf("%w: version must be an integer >= -1, got %q", errUsage, args[0])
+		}
+		return c.withManager(thenPrintStatus(func(mm *database.MigrationManager) error {
+			return mm.Force(version)
+		}))
+	case "status":
+		if err := expectArgs(args, 0); err != nil {
+			return err
+		}
+		return c.withManager(printStatus)
+	case "create":
+		if len(args) != 1 {
+			return fmt.Errorf("%w: create expects exactly one NAME argument", errUsage)
+		}
+		info, err := database.NewFileMigrationManager(c.migrationsPath).CreateMigration(args[0])
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Created %s\nCreated %s\n", info.UpFile, info.DownFile)
+		return nil
+	case "validate":
+		if len(args) != 0 {
+			return fmt.Errorf("%w: validate takes no arguments", errUsage)
+		}
+		if err := database.NewFileMigrationManager(c.migrationsPath).ValidateMigrations(); err != nil {
+			return err
+		}
+		fmt.Println("All migrations are valid")
+		return nil
+	}
+
+	return fmt.Errorf("%w: unknown command %q", errUsage, name)
+}
+
+// expectArgs checks the argument count of a command
+func expectArgs(args []string, nargs int) error {
+	if len(args) != nargs {
+		return fmt.Errorf("%w: expected %d argument(s), got %d", errUsage, nargs, len(args))
+	}
+	return nil
+}
+
+// withManager connects a MigrationManager and runs fn
+func (c *command) withManager(fn func(mm *database.MigrationManager) error) error {
+	conf, secret, err := config.LoadConfig(c.configPath, c.secretPath)
+	if err != nil {
+		return err
+	}
+
+	mm, err := database.NewMigrationManager(&conf.Database, &secret.Database, c.migrationsPath)
+	if err != nil {
+		return err
+	}
+	defer mm.Close()
+
+	return fn(mm)
+}
+
+// thenPrintStatus runs fn and reports the resulting migration status
+func thenPrintStatus(fn func(mm *database.MigrationManager) error) func(mm *database.MigrationManager) error {
+	return func(mm *database.MigrationManager) error {
+		if err := fn(mm); err != nil {
+			return err
+		}
+		return printStatus(mm)
+	}
+}
+
+func printStatus(mm *database.MigrationManager) error {
+	status, err := mm.GetStatus()
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(status.String())
+	for _, migration := range status.AppliedMigrations {
+		fmt.Printf("  [applied] %03d %s\n", migration.Version, migration.Name)
+	}
+	for _, migration := range status.PendingMigrations {
+		fmt.Printf("  [pending] %03d %s\n", migration.Version, migration.Name)
+	}
+
+	return nil
+}
@@ -1 +1,79 @@
-package migration
+// Command migration manages the database schema.
+//
+// Usage:
+//
+//	migration [flags] <command> [args]
+//
+// Commands:
+//
+//	up               apply all pending migrations
+//	down             roll back all migrations
+//	steps N          apply N migrations (negative N rolls back)
+//	to VERSION       migrate up or down to VERSION
+//	force VERSION    set the version without running migrations (clears dirty state)
+//	status           print the current migration status
+//	create NAME      create a new up/down migration pair
+//	validate         check migration files for gaps and empty files
+//
+// Exit codes: 0 on success, 1 when the command fails, 2 on invalid usage.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+)
+
+const (
+	exitOK      = 0
+	exitFailure = 1
+	exitUsage   = 2
+)
+
+// errUsage marks errors caused by invalid command-line usage
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+func run(args []string) int {
+	flags := flag.NewFlagSet("migration", flag.ContinueOnError)
+	configPath := flags.String("config", "./config.yaml", "path to the config file")
+	secretPath := flags.String("secret", "./secret.yaml", "path to the secret file (optional)")
+	migrationsPath := flags.String("path", "./migrations", "path to the migrations directory")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: migration [flags] <up|down|steps N|to VERSION|force VERSION|status|create NAME|validate>")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return exitUsage
+	}
+
+	cmd := &command{
+		configPath:     *configPath,
+		secretPath:     *secretPath,
+		migrationsPath: *migrationsPath,
+	}
+
+	if err := cmd.run(flags.Arg(0), flags.Args()[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "migration %s: %v\n", flags.Arg(0), err)
+		if errors.Is(err, errUsage) {
+			flags.Usage()
+			return exitUsage
+		}
+		return exitFailure
+	}
+
+	return exitOK
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// runOffline runs the command without a reachable database: the config
+// file is missing and database.name is unset, so any command that gets as
+// far as connecting fails with exitFailure
+func runOffline(t *testing.T, args ...string) int {
+	t.Helper()
+
+	t.Setenv("APP_SECRETS_DIR", t.TempDir())
+	t.Setenv("APP_DATABASE_NAME", "")
+	dir := t.TempDir()
+	return run(append([]string{
+		"-config", filepath.Join(dir, "config.yaml"),
+		"-secret", filepath.Join(dir, "secret.yaml"),
+		"-path", dir,
+	}, args...))
+}
+
+func TestRunExitCodes(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"help", []string{"-h"}, exitOK},
+		{"unknown flag", []string{"-bogus"}, exitUsage},
+		{"no command", nil, exitUsage},
+		{"unknown command", []string{"sideways"}, exitUsage},
+		{"up with an argument", []string{"up", "1"}, exitUsage},
+		{"status with an argument", []string{"status", "now"}, exitUsage},
+		{"steps without N", []string{"steps"}, exitUsage},
+		{"steps with a non-integer", []string{"steps", "two"}, exitUsage},
+		{"steps with zero", []string{"steps", "0"}, exitUsage},
+		{"steps with extra arguments", []string{"steps", "1", "2"}, exitUsage},
+		{"to without VERSION", []string{"to"}, exitUsage},
+		{"to with a non-integer", []string{"to", "latest"}, exitUsage},
+		{"to with a negative version", []string{"to", "-1"}, exitUsage},
+		{"force without VERSION", []string{"force"}, exitUsage},
+		{"force with a non-integer", []string{"force", "x"}, exitUsage},
+		{"force below -1", []string{"force", "-2"}, exitUsage},
+		{"create without NAME", []string{"create"}, exitUsage},
+		{"validate with an argument", []string{"validate", "all"}, exitUsage},
+		// valid usage reaches the configuration and fails there
+		{"up without a database", []string{"up"}, exitFailure},
+		{"steps without a database", []string{"steps", "-1"}, exitFailure},
+		{"force without a database", []string{"force", "-1"}, exitFailure},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := runOffline(t, tt.args...); got != tt.want {
+				t.Fatalf("run(%q) = %d, want %d", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunCreate(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, name := range []string{"Add orders-table", "add_index"} {
+		if got := run([]string{"-path", dir, "create", name}); got != exitOK {
+			t.Fatalf("create %q: exit code %d, want %d", name, got, exitOK)
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("read migrations: %v", err)
+	}
+	var files []string
+	for _, entry := range entries {
+		files = append(files, entry.Name())
+	}
+	want := []string{
+		"001_add_orders_table.down.sql",
+		"001_add_orders_table.up.sql",
+		"002_add_index.down.sql",
+		"002_add_index.up.sql",
+	}
+	if !reflect.DeepEqual(files, want) {
+		t.Fatalf("created %v, want %v", files, want)
+	}
+
+	// the templates hold no SQL until they are filled in
+	if got := run([]string{"-path", dir, "validate"}); got != exitFailure {
+		t.Fatalf("validate empty templates: exit code %d, want %d", got, exitFailure)
+	}
+	for _, file := range files {
+		if err := os.WriteFile(filepath.Join(dir, file), []byte("SELECT 1;\n"), 0o644); err != nil {
+			t.Fatalf("write %s: %v", file, err)
+		}
+	}
+	if got := run([]string{"-path", dir, "validate"}); got != exitOK {
+		t.Fatalf("validate filled migrations: exit code %d, want %d", got, exitOK)
+	}
+}
@@ -1,13 +1,89 @@
 package config
 
+import "time"
+
 type Config struct {
-	Database DatabaseConfig `mapstructure:"database"`
+	App        AppConfig        `mapstructure:"app"`
+	Server     ServerConfig     `mapstructure:"server"`
+	Log        LogConfig        `mapstructure:"log"`
+	Database   DatabaseConfig   `mapstructure:"database"`
+	Auth       AuthConfig       `mapstructure:"auth"`
+	Pagination PaginationConfig `mapstructure:"pagination"`
+	Outbox     OutboxConfig     `mapstructure:"outbox"`
+	Tracing    TracingConfig    `mapstructure:"tracing"`
+}
+
+type AppConfig struct {
+	Name        string `mapstructure:"name"`
+	Environment string `mapstructure:"environment"`
+}
+
+type ServerConfig struct {
+	Address      string        `mapstructure:"address"`
+	ReadTimeout  time.Duration `mapstructure:"read_timeout"`
+	WriteTimeout time.Duration `mapstructure:"write_timeout"`
+	IdleTimeout  time.Duration `mapstructure:"idle_timeout"`
+	// BodyLimit is the maximum request body size in bytes
+	BodyLimit int `mapstructure:"body_limit"`
+	// ShutdownTimeout bounds how long in-flight requests may drain on shutdown
+	ShutdownTimeout time.Duration `mapstructure:"shutdown_timeout"`
+}
+
+type LogConfig struct {
+	// Level is one of debug, info, warn or error
+	Level string `mapstructure:"level"`
+	// Format is text or json
+	Format string `mapstructure:"format"`
 }
 
 type DatabaseConfig struct {
-	URL     string `mapstructure:"url"`
-	Host    string `mapstructure:"host"`
-	Port    string `mapstructure:"port"`
-	Name    string `mapstructure:"name"`
-	SSLMode string `mapstructure:"sslmode"`
+	URL     string     `mapstructure:"url"`
+	Host    string     `mapstructure:"host"`
+	Port    string     `mapstructure:"port"`
+	Name    string     `mapstructure:"name"`
+	SSLMode string     `mapstructure:"sslmode"`
+	Pool    PoolConfig `mapstructure:"pool"`
+
+	// SlowQueryThreshold is the statement duration above which a warning is
+	// logged; zero or negative disables the warning
+	SlowQueryThreshold time.Duration `mapstructure:"slow_query_threshold"`
+}
+
+type PoolConfig struct {
+	MaxOpenConns    int           `mapstructure:"max_open_conns"`
+	MaxIdleConns    int           `mapstructure:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `mapstructure:"conn_max_lifetime"`
+	ConnMaxIdleTime time.Duration `mapstructure:"conn_max_idle_time"`
+	ConnectTimeout  time.Duration `mapstructure:"connect_timeout"`
+}
+
+type AuthConfig struct {
+	SessionTTL   time.Duration `mapstructure:"session_ttl"`
+	CookieName   string        `mapstructure:"cookie_name"`
+	CookieSecure bool          `mapstructure:"cookie_secure"`
+	// SessionCleanupInterval controls how often expired sessions are purged.
+	// A negative value disables the cleanup job.
+	SessionCleanupInterval time.Duration `mapstructure:"session_cleanup_interval"`
+}
+
+type PaginationConfig struct {
+	DefaultLimit int `mapstructure:"default_limit"`
+	MaxLimit     int `mapstructure:"max_limit"`
+}
+
+type OutboxConfig struct {
+	// PollInterval controls how often the relay looks for pending events.
+	// A negative value disables the relay.
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	BatchSize    int           `mapstructure:"batch_size"`
+	// MaxAttempts stops retrying an event after this many failures.
+	// A negative value retries forever.
+	MaxAttempts int           `mapstructure:"max_attempts"`
+	MinBackoff  time.Duration `mapstructure:"min_backoff"`
+	MaxBackoff  time.Duration `mapstructure:"max_backoff"`
+}
+
+type TracingConfig struct {
+	// Exporter is none or stdout
+	Exporter string `mapstructure:"exporter"`
 }
@@ -0,0 +1,86 @@
+package config
+
+import (
+	"github.com/fbriansyah/go-modular/constants"
+	"github.com/spf13/viper"
+)
+
+// setDefaults registers the value of every config key that is not set in
+// the config file or the environment. Registering every key also makes
+// viper consult its APP_* variable when unmarshalling.
+//
+//	app.name                      go-modular
+//	app.environment               development
+//	server.address                :8080
+//	server.read_timeout           15s
+//	server.write_timeout          15s
+//	server.idle_timeout           60s
+//	server.body_limit             4194304 (4 MiB)
+//	server.shutdown_timeout       15s
+//	log.level                     info (debug, info, warn, error)
+//	log.format                    text (text, json)
+//	database.url                  (empty: built from host, port, name, sslmode)
+//	database.host                 localhost
+//	database.port                 5432
+//	database.name                 (required)
+//	database.sslmode              disable
+//	database.pool.max_open_conns      25
+//	database.pool.max_idle_conns      5
+//	database.pool.conn_max_lifetime   5m
+//	database.pool.conn_max_idle_time  5m
+//	database.pool.connect_timeout     30s
+//	database.slow_query_threshold     200ms (0 disables)
+//	auth.session_ttl              24h
+//	auth.cookie_name              session_id
+//	auth.cookie_secure            false
+//	auth.session_cleanup_interval 15m
+//	pagination.default_limit      10
+//	pagination.max_limit          100
+//	outbox.poll_interval          1s
+//	outbox.batch_size             100
+//	outbox.max_attempts           10
+//	outbox.min_backoff            1s
+//	outbox.max_backoff            5m
+//	tracing.exporter              none (none, stdout)
+func setDefaults(v *viper.Viper) {
+	v.SetDefault("app.name", "go-modular")
+	v.SetDefault("app.environment", "development")
+
+	v.SetDefault("server.address", constants.DefaultServerAddress)
+	v.SetDefault("server.read_timeout", "15s")
+	v.SetDefault("server.write_timeout", "15s")
+	v.SetDefault("server.idle_timeout", "60s")
+	v.SetDefault("server.body_limit", constants.DefaultBodyLimit)
+	v.SetDefault("server.shutdown_timeout", constants.DefaultShutdownTimeout)
+
+	v.SetDefault("log.level", constants.DefaultLogLevel)
+	v.SetDefault("log.format", constants.DefaultLogFormat)
+
+	v.SetDefault("database.url", "")
+	v.SetDefault("database.host", "localhost")
+	v.SetDefault("database.port", "5432")
+	v.SetDefault("database.name", "")
+	v.SetDefault("database.sslmode", "disable")
+	v.SetDefault("database.pool.max_open_conns", 25)
+	v.SetDefault("database.pool.max_idle_conns", 5)
+	v.SetDefault("database.pool.conn_max_lifetime", "5m")
+	v.SetDefault("database.pool.conn_max_idle_time", "5m")
+	v.SetDefault("database.pool.connect_timeout", constants.DefaultConnectTimeout)
+	v.SetDefault("database.slow_query_threshold", constants.DefaultSlowQueryThreshold)
+
+	v.SetDefault("auth.session_ttl", constants.DefaultSessionTTL)
+	v.SetDefault("auth.cookie_name", constants.DefaultSessionCookieName)
+	v.SetDefault("auth.cookie_secure", false)
+	v.SetDefault("auth.session_cleanup_interval", constants.DefaultSessionCleanupInterval)
+
+	v.SetDefault("pagination.default_limit", constants.DefaultPageLimit)
+	v.SetDefault("pagination.max_limit", constants.DefaultMaxPageLimit)
+
+	v.SetDefault("outbox.poll_interval", constants.DefaultOutboxPollInterval)
+	v.SetDefault("outbox.batch_size", 100)
+	v.SetDefault("outbox.max_attempts", 10)
+	v.SetDefault("outbox.min_backoff", "1s")
+	v.SetDefault("outbox.max_backoff", "5m")
+
+	v.SetDefault("tracing.exporter", "none")
+}
@@ -1,33 +1,65 @@
 package config
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/viper"
 )
 
+// EnvPrefix prefixes every environment override. A key's variable is the
+// prefix plus its path in upper case with dots replaced by underscores,
+// e.g. database.host is APP_DATABASE_HOST and database.password is
+// APP_DATABASE_PASSWORD.
+const EnvPrefix = "APP"
+
+// LoadConfig builds the configuration from, in increasing precedence, the
+// defaults (see setDefaults), the config file and APP_* environment
+// variables, resolves secrets through DefaultSecretProviders, then
+// validates the result.
+//
+// An empty configPath skips the config file. The secret file is optional:
+// when it does not exist, secrets are expected in the environment or in
+// mounted secret files.
 func LoadConfig(configPath, secretPath string) (*Config, *Secret, error) {
-	// Load Config
-	viper.SetConfigFile(configPath)
-	if err := viper.ReadInConfig(); err != nil {
-		return nil, nil, fmt.Errorf("error reading config file: %w", err)
+	v := newViper()
+	setDefaults(v)
+
+	if configPath != "" {
+		v.SetConfigFile(configPath)
+		if err := v.ReadInConfig(); err != nil {
+			return nil, nil, fmt.Errorf("error reading config file: %w", err)
+		}
 	}
+
 	var config Config
-	if err := viper.Unmarshal(&config); err != nil {
-		return nil, nil, fmt.Errorf("unable to decode into struct: %w", err)
+	if err := v.Unmarshal(&config); err != nil {
+		return nil, nil, fmt.Errorf("unable to decode config: %w", err)
 	}
 
-	viper.Reset()
+	providers, err := DefaultSecretProviders(secretPath)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	// Load Secret
-	viper.SetConfigFile(secretPath)
-	var secret Secret
-	if err := viper.ReadInConfig(); err != nil {
-		return nil, nil, fmt.Errorf("error reading secret file: %w", err)
+	secret, err := LoadSecret(context.Background(), providers...)
+	if err != nil {
+		return nil, nil, err
 	}
-	if err := viper.Unmarshal(&secret); err != nil {
-		return nil, nil, fmt.Errorf("unable to decode into struct: %w", err)
+
+	if err := Validate(&config, secret); err != nil {
+		return nil, nil, err
 	}
 
-	return &config, &secret, nil
+	return &config, secret, nil
+}
+
+// newViper creates an isolated viper instance reading APP_* overrides
+func newViper() *viper.Viper {
+	v := viper.New()
+	v.SetEnvPrefix(EnvPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
+	return v
 }
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fbriansyah/go-modular/constants"
+)
+
+// isolateEnv keeps the host's secrets directory out of the test and sets the
+// settings Validate requires that have no default
+func isolateEnv(t *testing.T) {
+	t.Helper()
+
+	t.Setenv(EnvPrefix+"_SECRETS_DIR", t.TempDir())
+	t.Setenv(EnvPrefix+"_DATABASE_NAME", "app")
+	t.Setenv(EnvPrefix+"_DATABASE_USERNAME", "app")
+}
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	return path
+}
+
+func TestLoadConfigEnvOverridesFileAndDefaults(t *testing.T) {
+	isolateEnv(t)
+	path := writeConfigFile(t, `
+server:
+  address: ":9000"
+database:
+  host: file-host
+`)
+	t.Setenv("APP_DATABASE_HOST", "env-host")
+	t.Setenv("APP_SERVER_READ_TIMEOUT", "3s")
+
+	conf, _, err := LoadConfig(path, "")
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+
+	if conf.Database.Host != "env-host" {
+		t.Errorf("database.host = %q, want the env override over the file", conf.Database.Host)
+	}
+	if conf.Server.ReadTimeout != 3*time.Second {
+		t.Errorf("server.read_timeout = %s, want the env override over the default", conf.Server.ReadTimeout)
+	}
+	if conf.Server.Address != ":9000" {
+		t.Errorf("server.address = %q, want the file value over the default", conf.Server.Address)
+	}
+}
+
+func TestLoadConfigAppliesDefaults(t *testing.T) {
+	isolateEnv(t)
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{"without a config file", ""},
+		{"with a partial config file", writeConfigFile(t, "app:\n  name: partial\n")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf, _, err := LoadConfig(tt.path, filepath.Join(t.TempDir(), "missing-secret.yaml"))
+			if err != nil {
+				t.Fatalf("LoadConfig: %v", err)
+			}
+
+			if conf.Server.Address != constants.DefaultServerAddress {
+				t.Errorf("server.address = %q, want default %q", conf.Server.Address, constants.DefaultServerAddress)
+			}
+			if conf.Server.ShutdownTimeout != constants.DefaultShutdownTimeout {
+				t.Errorf("server.shutdown_timeout = %s, want default %s", conf.Server.ShutdownTimeout, constants.DefaultShutdownTimeout)
+			}
+			if conf.Database.Host != "localhost" || conf.Database.Port != "5432" {
+				t.Errorf("database = %s:%s, want default localhost:5432", conf.Database.Host, conf.Database.Port)
+			}
+			if conf.Database.Pool.MaxOpenConns != 25 {
+				t.Errorf("database.pool.max_open_conns = %d, want default 25", conf.Database.Pool.MaxOpenConns)
+			}
+		})
+	}
+}
+
+func TestLoadConfigMissingConfigFile(t *testing.T) {
+	isolateEnv(t)
+
+	if _, _, err := LoadConfig(filepath.Join(t.TempDir(), "missing.yaml"), ""); err == nil {
+		t.Fatal("LoadConfig with an explicit missing config file: got nil error")
+	}
+}
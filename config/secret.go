@@ -1,10 +1,65 @@
 package config
 
+import (
+	"log/slog"
+)
+
 type Secret struct {
 	Database DatabaseSecret `mapstructure:"database"`
 }
 
 type DatabaseSecret struct {
-	Username string `mapstructure:"username"`
-	Password string `mapstructure:"password"`
+	Username string       `mapstructure:"username"`
+	Password SecretString `mapstructure:"password"`
+}
+
+// redacted replaces secret values in every printable representation
+const redacted = "****"
+
+// SecretString holds a sensitive value. Printing, logging or marshalling it
+// yields "****"; call Reveal where the actual value is needed.
+type SecretString string
+
+// Reveal returns the secret value
+func (s SecretString) Reveal() string {
+	return string(s)
+}
+
+// IsEmpty reports whether no secret is set
+func (s SecretString) IsEmpty() bool {
+	return s == ""
+}
+
+// String implements fmt.Stringer
+func (s SecretString) String() string {
+	return redacted
+}
+
+// GoString implements fmt.GoStringer, covering %#v
+func (s SecretString) GoString() string {
+	return redacted
+}
+
+// MarshalJSON implements json.Marshaler
+func (s SecretString) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + redacted + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaler, covering YAML and slog's text handler
+func (s SecretString) MarshalText() ([]byte, error) {
+	return []byte(redacted), nil
+}
+
+// LogValue implements slog.LogValuer
+func (s SecretString) LogValue() slog.Value {
+	return slog.StringValue(redacted)
+}
+
+// secretFields lists every secret key and where it is stored
+var secretFields = []struct {
+	key string
+	set func(s *Secret, value string)
+}{
+	{"database.username", func(s *Secret, v string) { s.Database.Username = v }},
+	{"database.password", func(s *Secret, v string) { s.Database.Password = SecretString(v) }},
 }
@@ -0,0 +1,177 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// DefaultSecretsDir is where Docker mounts secrets; override it with APP_SECRETS_DIR
+const DefaultSecretsDir = "/run/secrets"
+
+// SecretProvider looks up secrets by key, e.g. "database.password"
+type SecretProvider interface {
+	// Name identifies the provider in errors
+	Name() string
+	// Lookup returns the value of key and whether the provider has it
+	Lookup(ctx context.Context, key string) (string, bool, error)
+}
+
+// LoadSecret resolves every secret key from the first provider that has it,
+// so earlier providers take precedence
+func LoadSecret(ctx context.Context, providers ...SecretProvider) (*Secret, error) {
+	var secret Secret
+
+	for _, field := range secretFields {
+		for _, provider := range providers {
+			value, ok, err := provider.Lookup(ctx, field.key)
+			if err != nil {
+				return nil, fmt.Errorf("secret provider %s failed to look up %s: %w", provider.Name(), field.key, err)
+			}
+			if ok {
+				field.set(&secret, value)
+				break
+			}
+		}
+	}
+
+	return &secret, nil
+}
+
+// DefaultSecretProviders returns the providers LoadConfig uses, highest
+// precedence first: APP_* environment variables, files mounted in
+// APP_SECRETS_DIR (default /run/secrets), then the optional secret file
+func DefaultSecretProviders(secretPath string) ([]SecretProvider, error) {
+	secretsDir := os.Getenv(EnvPrefix + "_SECRETS_DIR")
+	if secretsDir == "" {
+		secretsDir = DefaultSecretsDir
+	}
+
+	providers := []SecretProvider{
+		NewEnvSecretProvider(EnvPrefix),
+		NewMountedSecretProvider(secretsDir),
+	}
+
+	if secretPath != "" {
+		file, err := NewFileSecretProvider(secretPath)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, file)
+	}
+
+	return providers, nil
+}
+
+// EnvSecretProvider reads secrets from environment variables named like
+// config overrides, e.g. APP_DATABASE_PASSWORD. A variable with a _FILE
+// suffix, e.g. APP_DATABASE_PASSWORD_FILE, names a file holding the value.
+type EnvSecretProvider struct {
+	prefix string
+}
+
+// NewEnvSecretProvider creates a provider reading prefix_* variables
+func NewEnvSecretProvider(prefix string) *EnvSecretProvider {
+	return &EnvSecretProvider{prefix: prefix}
+}
+
+func (p *EnvSecretProvider) Name() string {
+	return "env"
+}
+
+func (p *EnvSecretProvider) Lookup(ctx context.Context, key string) (string, bool, error) {
+	name := p.prefix + "_" + strings.ToUpper(strings.ReplaceAll(key, ".", "_"))
+
+	if value, ok := os.LookupEnv(name); ok {
+		return value, true, nil
+	}
+
+	if path, ok := os.LookupEnv(name + "_FILE"); ok {
+		value, err := readSecretFile(path)
+		if err != nil {
+			return "", false, err
+		}
+		return value, true, nil
+	}
+
+	return "", false, nil
+}
+
+// MountedSecretProvider reads one secret per file from a directory, as
+// mounted by Docker secrets or a Kubernetes secret volume. The file for
+// database.password is database_password, or database.password if present.
+// A missing directory provides nothing.
+type MountedSecretProvider struct {
+	dir string
+}
+
+// NewMountedSecretProvider creates a provider reading files in dir
+func NewMountedSecretProvider(dir string) *MountedSecretProvider {
+	return &MountedSecretProvider{dir: dir}
+}
+
+func (p *MountedSecretProvider) Name() string {
+	return "mounted:" + p.dir
+}
+
+func (p *MountedSecretProvider) Lookup(ctx context.Context, key string) (string, bool, error) {
+	for _, name := range []string{key, strings.ReplaceAll(key, ".", "_")} {
+		value, err := readSecretFile(filepath.Join(p.dir, name))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return "", false, err
+		}
+		return value, true, nil
+	}
+	return "", false, nil
+}
+
+// FileSecretProvider reads secrets from a YAML file shaped like .example.secret.yaml
+type FileSecretProvider struct {
+	path string
+	v    *viper.Viper
+}
+
+// NewFileSecretProvider reads the secret file at path. A missing file provides nothing.
+func NewFileSecretProvider(path string) (*FileSecretProvider, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("error reading secret file: %w", err)
+	}
+	return &FileSecretProvider{path: path, v: v}, nil
+}
+
+func (p *FileSecretProvider) Name() string {
+	return "file:" + p.path
+}
+
+func (p *FileSecretProvider) Lookup(ctx context.Context, key string) (string, bool, error) {
+	if !p.v.IsSet(key) {
+		return "", false, nil
+	}
+	return p.v.GetString(key), true, nil
+}
+
+// readSecretFile reads a secret file, dropping the trailing newline editors and `echo` add
+func readSecretFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+var (
+	_ SecretProvider = (*EnvSecretProvider)(nil)
+	_ SecretProvider = (*MountedSecretProvider)(nil)
+	_ SecretProvider = (*FileSecretProvider)(nil)
+)
@@ -0,0 +1,137 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testPassword = "hunter2-s3cret"
+
+func TestSecretStringRedacts(t *testing.T) {
+	secret := Secret{Database: DatabaseSecret{Username: "app", Password: testPassword}}
+
+	var jsonOut []byte
+	var err error
+	if jsonOut, err = json.Marshal(secret); err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+
+	var textLog, jsonLog bytes.Buffer
+	slog.New(slog.NewTextHandler(&textLog, nil)).Info("secret", "password", secret.Database.Password, "secret", secret)
+	slog.New(slog.NewJSONHandler(&jsonLog, nil)).Info("secret", "password", secret.Database.Password, "secret", secret)
+
+	outputs := map[string]string{
+		"%v":        fmt.Sprintf("%v", secret),
+		"%+v":       fmt.Sprintf("%+v", secret),
+		"%#v":       fmt.Sprintf("%#v", secret),
+		"%s":        fmt.Sprintf("%s", secret.Database.Password),
+		"json":      string(jsonOut),
+		"slog text": textLog.String(),
+		"slog json": jsonLog.String(),
+	}
+	for name, out := range outputs {
+		if strings.Contains(out, testPassword) {
+			t.Errorf("%s leaks the secret: %s", name, out)
+		}
+		if !strings.Contains(out, redacted) {
+			t.Errorf("%s does not show the redaction marker: %s", name, out)
+		}
+	}
+
+	if secret.Database.Password.Reveal() != testPassword {
+		t.Fatal("Reveal does not return the secret value")
+	}
+}
+
+func writeSecretFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write %s: %v", name, err)
+	}
+	return path
+}
+
+func TestEnvSecretProviderReadsFileVariable(t *testing.T) {
+	path := writeSecretFile(t, t.TempDir(), "password", testPassword+"\n")
+	t.Setenv("TEST_DATABASE_PASSWORD_FILE", path)
+
+	value, ok, err := NewEnvSecretProvider("TEST").Lookup(context.Background(), "database.password")
+	if err != nil || !ok || value != testPassword {
+		t.Fatalf("Lookup = %q, %v, %v, want the file content without its trailing newline", value, ok, err)
+	}
+
+	// the variable itself wins over its _FILE variant
+	t.Setenv("TEST_DATABASE_PASSWORD", "from-env")
+	if value, _, _ := NewEnvSecretProvider("TEST").Lookup(context.Background(), "database.password"); value != "from-env" {
+		t.Fatalf("Lookup = %q, want the variable over the _FILE variant", value)
+	}
+}
+
+func TestMountedSecretProvider(t *testing.T) {
+	dir := t.TempDir()
+	writeSecretFile(t, dir, "database_password", testPassword+"\r\n")
+	provider := NewMountedSecretProvider(dir)
+	ctx := context.Background()
+
+	if value, ok, err := provider.Lookup(ctx, "database.password"); err != nil || !ok || value != testPassword {
+		t.Fatalf("Lookup = %q, %v, %v, want the trimmed file content", value, ok, err)
+	}
+
+	// the dotted file name takes precedence over the underscored one
+	writeSecretFile(t, dir, "database.password", "dotted\n")
+	if value, _, _ := provider.Lookup(ctx, "database.password"); value != "dotted" {
+		t.Fatalf("Lookup = %q, want the dotted file", value)
+	}
+
+	if _, ok, err := provider.Lookup(ctx, "database.username"); ok || err != nil {
+		t.Fatalf("Lookup of a missing file = %v, %v, want not found", ok, err)
+	}
+	if _, ok, err := NewMountedSecretProvider(filepath.Join(dir, "missing")).Lookup(ctx, "database.password"); ok || err != nil {
+		t.Fatalf("Lookup in a missing directory = %v, %v, want not found", ok, err)
+	}
+}
+
+func TestDefaultSecretProvidersPrecedence(t *testing.T) {
+	mounted := t.TempDir()
+	writeSecretFile(t, mounted, "database_username", "mounted-user\n")
+	writeSecretFile(t, mounted, "database_password", "mounted-password\n")
+	secretFile := writeSecretFile(t, t.TempDir(), "secret.yaml",
+		"database:\n  username: file-user\n  password: file-password\n")
+
+	t.Setenv(EnvPrefix+"_SECRETS_DIR", mounted)
+	t.Setenv(EnvPrefix+"_DATABASE_PASSWORD", "env-password")
+
+	providers, err := DefaultSecretProviders(secretFile)
+	if err != nil {
+		t.Fatalf("DefaultSecretProviders: %v", err)
+	}
+	secret, err := LoadSecret(context.Background(), providers...)
+	if err != nil {
+		t.Fatalf("LoadSecret: %v", err)
+	}
+
+	if got := secret.Database.Password.Reveal(); got != "env-password" {
+		t.Errorf("password = %q, want the environment over mounted and file secrets", got)
+	}
+	if got := secret.Database.Username; got != "mounted-user" {
+		t.Errorf("username = %q, want the mounted secret over the file", got)
+	}
+
+	os.Remove(filepath.Join(mounted, "database_username"))
+	secret, err = LoadSecret(context.Background(), providers...)
+	if err != nil {
+		t.Fatalf("LoadSecret: %v", err)
+	}
+	if got := secret.Database.Username; got != "file-user" {
+		t.Errorf("username = %q, want the secret file as the last resort", got)
+	}
+}
@@ -1 +1,90 @@
 package config
+
+import (
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/fbriansyah/go-modular/constants"
+)
+
+// IsProduction reports whether the application runs in the production environment
+func (a AppConfig) IsProduction() bool {
+	env := strings.ToLower(a.Environment)
+	return env == "production" || env == "prod"
+}
+
+// PageLimits returns the configured default and maximum page sizes, falling back to the package defaults
+func (p PaginationConfig) PageLimits() (defaultLimit, maxLimit int) {
+	defaultLimit, maxLimit = p.DefaultLimit, p.MaxLimit
+	if defaultLimit <= 0 {
+		defaultLimit = constants.DefaultPageLimit
+	}
+	if maxLimit <= 0 {
+		maxLimit = constants.DefaultMaxPageLimit
+	}
+	if defaultLimit > maxLimit {
+		defaultLimit = maxLimit
+	}
+	return defaultLimit, maxLimit
+}
+
+// GetShutdownTimeout returns the configured drain timeout, falling back to the default
+func (s ServerConfig) GetShutdownTimeout() time.Duration {
+	if s.ShutdownTimeout > 0 {
+		return s.ShutdownTimeout
+	}
+	return constants.DefaultShutdownTimeout
+}
+
+// GetPollInterval returns the configured relay interval, falling back to the default
+func (o OutboxConfig) GetPollInterval() time.Duration {
+	if o.PollInterval == 0 {
+		return constants.DefaultOutboxPollInterval
+	}
+	return o.PollInterval
+}
+
+// GetAddress returns the configured listen address, falling back to the default
+func (s ServerConfig) GetAddress() string {
+	if s.Address != "" {
+		return s.Address
+	}
+	return constants.DefaultServerAddress
+}
+
+// GetBodyLimit returns the configured request body limit, falling back to the default
+func (s ServerConfig) GetBodyLimit() int {
+	if s.BodyLimit > 0 {
+		return s.BodyLimit
+	}
+	return constants.DefaultBodyLimit
+}
+
+// GetConnectTimeout returns the configured connect timeout, falling back to the default
+func (p PoolConfig) GetConnectTimeout() time.Duration {
+	if p.ConnectTimeout > 0 {
+		return p.ConnectTimeout
+	}
+	return constants.DefaultConnectTimeout
+}
+
+// GetLevel returns the configured log level, falling back to info
+func (l LogConfig) GetLevel() slog.Level {
+	if level, ok := logLevels[strings.ToLower(l.Level)]; ok {
+		return level
+	}
+	return slog.LevelInfo
+}
+
+// IsJSON reports whether logs are written as JSON
+func (l LogConfig) IsJSON() bool {
+	return strings.ToLower(l.Format) == "json"
+}
+
+var logLevels = map[string]slog.Level{
+	"debug": slog.LevelDebug,
+	"info":  slog.LevelInfo,
+	"warn":  slog.LevelWarn,
+	"error": slog.LevelError,
+}
@@ -0,0 +1,88 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidConfig is returned when the loaded configuration is incomplete or inconsistent
+var ErrInvalidConfig = errors.New("invalid config")
+
+// Validate checks required fields and value ranges, reporting every problem at once
+func Validate(conf *Config, secret *Secret) error {
+	var problems []string
+	addf := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	if conf.App.Name == "" {
+		addf("app.name is required")
+	}
+
+	// a connection URL carries every connection setting, credentials included
+	if conf.Database.URL == "" {
+		if conf.Database.Host == "" {
+			addf("database.host is required")
+		}
+		if port, err := strconv.Atoi(conf.Database.Port); err != nil || port <= 0 || port > 65535 {
+			addf("database.port must be a port number, got %q", conf.Database.Port)
+		}
+		if conf.Database.Name == "" {
+			addf("database.name is required")
+		}
+		if secret.Database.Username == "" {
+			addf("database.username is required (secret file or %s_DATABASE_USERNAME)", EnvPrefix)
+		}
+	}
+
+	if conf.Server.ReadTimeout < 0 || conf.Server.WriteTimeout < 0 || conf.Server.IdleTimeout < 0 {
+		addf("server timeouts must not be negative")
+	}
+	if conf.Server.BodyLimit < 0 {
+		addf("server.body_limit must not be negative")
+	}
+	if conf.Server.ShutdownTimeout < 0 {
+		addf("server.shutdown_timeout must not be negative")
+	}
+	if _, ok := logLevels[strings.ToLower(conf.Log.Level)]; conf.Log.Level != "" && !ok {
+		addf("log.level must be one of debug, info, warn, error, got %q", conf.Log.Level)
+	}
+	if format := strings.ToLower(conf.Log.Format); format != "" && format != "text" && format != "json" {
+		addf("log.format must be text or json, got %q", conf.Log.Format)
+	}
+	if conf.Database.Pool.MaxOpenConns < 0 || conf.Database.Pool.MaxIdleConns < 0 {
+		addf("database.pool connection limits must not be negative")
+	}
+	if conf.Database.Pool.MaxOpenConns > 0 && conf.Database.Pool.MaxIdleConns > conf.Database.Pool.MaxOpenConns {
+		addf("database.pool.max_idle_conns (%d) exceeds database.pool.max_open_conns (%d)", conf.Database.Pool.MaxIdleConns, conf.Database.Pool.MaxOpenConns)
+	}
+	if conf.Database.Pool.ConnectTimeout < 0 {
+		addf("database.pool.connect_timeout must not be negative")
+	}
+	if conf.Auth.SessionTTL < 0 {
+		addf("auth.session_ttl must not be negative")
+	}
+	if conf.Pagination.DefaultLimit < 0 || conf.Pagination.MaxLimit < 0 {
+		addf("pagination limits must not be negative")
+	}
+	if conf.Pagination.MaxLimit > 0 && conf.Pagination.DefaultLimit > conf.Pagination.MaxLimit {
+		addf("pagination.default_limit (%d) exceeds pagination.max_limit (%d)", conf.Pagination.DefaultLimit, conf.Pagination.MaxLimit)
+	}
+	if conf.Outbox.BatchSize < 0 {
+		addf("outbox.batch_size must not be negative")
+	}
+	if conf.Outbox.MinBackoff > 0 && conf.Outbox.MaxBackoff > 0 && conf.Outbox.MinBackoff > conf.Outbox.MaxBackoff {
+		addf("outbox.min_backoff (%s) exceeds outbox.max_backoff (%s)", conf.Outbox.MinBackoff, conf.Outbox.MaxBackoff)
+	}
+
+	if exporter := strings.ToLower(conf.Tracing.Exporter); exporter != "" && exporter != "none" && exporter != "stdout" {
+		addf("tracing.exporter must be none or stdout, got %q", conf.Tracing.Exporter)
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%w:\n  - %s", ErrInvalidConfig, strings.Join(problems, "\n  - "))
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package config
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateReportsEveryProblem(t *testing.T) {
+	conf := &Config{}
+	conf.Database.Port = "not-a-port"
+	conf.Server.BodyLimit = -1
+	conf.Log.Level = "verbose"
+	conf.Pagination.DefaultLimit = 50
+	conf.Pagination.MaxLimit = 10
+
+	err := Validate(conf, &Secret{})
+	if !errors.Is(err, ErrInvalidConfig) {
+		t.Fatalf("Validate: got %v, want ErrInvalidConfig", err)
+	}
+
+	for _, want := range []string{
+		"app.name is required",
+		"database.host is required",
+		"database.port must be a port number",
+		"database.name is required",
+		"database.username is required",
+		"server.body_limit must not be negative",
+		"log.level must be one of",
+		"pagination.default_limit (50) exceeds pagination.max_limit (10)",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error does not report %q:\n%v", want, err)
+		}
+	}
+}
+
+func TestValidateAcceptsDatabaseURL(t *testing.T) {
+	conf := &Config{}
+	conf.App.Name = "app"
+	conf.Database.URL = "postgres://app@db/app"
+
+	if err := Validate(conf, &Secret{}); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+}
@@ -1 +1,44 @@
 package constants
+
+import "time"
+
+const (
+	// DefaultServerAddress is used when server.address is not configured
+	DefaultServerAddress = ":8080"
+
+	// DefaultBodyLimit is used when server.body_limit is not configured
+	DefaultBodyLimit = 4 * 1024 * 1024
+
+	// DefaultLogLevel is used when log.level is not configured
+	DefaultLogLevel = "info"
+
+	// DefaultLogFormat is used when log.format is not configured
+	DefaultLogFormat = "text"
+
+	// DefaultConnectTimeout is used when database.pool.connect_timeout is not configured
+	DefaultConnectTimeout = 30 * time.Second
+
+	// DefaultSlowQueryThreshold is used when database.slow_query_threshold is not configured
+	DefaultSlowQueryThreshold = 200 * time.Millisecond
+
+	// DefaultShutdownTimeout is used when server.shutdown_timeout is not configured
+	DefaultShutdownTimeout = 15 * time.Second
+
+	// DefaultSessionTTL is used when auth.session_ttl is not configured
+	DefaultSessionTTL = 24 * time.Hour
+
+	// DefaultSessionCookieName is used when auth.cookie_name is not configured
+	DefaultSessionCookieName = "session_id"
+
+	// DefaultSessionCleanupInterval is used when auth.session_cleanup_interval is not configured
+	DefaultSessionCleanupInterval = 15 * time.Minute
+
+	// DefaultPageLimit is used when pagination.default_limit is not configured
+	DefaultPageLimit = 10
+
+	// DefaultMaxPageLimit is used when pagination.max_limit is not configured
+	DefaultMaxPageLimit = 100
+
+	// DefaultOutboxPollInterval is used when outbox.poll_interval is not configured
+	DefaultOutboxPollInterval = time.Second
+)
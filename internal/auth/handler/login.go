@@ -0,0 +1,31 @@
+package authHandler
+
+import (
+	"github.com/fbriansyah/go-modular/internal/model"
+	authModel "github.com/fbriansyah/go-modular/internal/model/auth"
+	"github.com/gofiber/fiber/v2"
+)
+
+func (a *AuthHandler) Login(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	req := &authModel.LoginRequest{}
+	if err := c.BodyParser(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid request body")
+	}
+	req.IPAddress = c.IP()
+	req.UserAgent = c.Get(fiber.HeaderUserAgent)
+
+	resp, err := a.authService.Login(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	a.setSessionCookie(c, resp.Token, resp.ExpiresAt)
+
+	return c.Status(fiber.StatusOK).JSON(model.HttpResponse{
+		Code:    fiber.StatusOK,
+		Message: "login successful",
+		Data:    resp,
+	})
+}
@@ -0,0 +1,21 @@
+package authHandler
+
+import (
+	"github.com/fbriansyah/go-modular/internal/model"
+	"github.com/gofiber/fiber/v2"
+)
+
+func (a *AuthHandler) Logout(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	if err := a.authService.Logout(ctx, a.extractToken(c)); err != nil {
+		return err
+	}
+
+	a.clearSessionCookie(c)
+
+	return c.Status(fiber.StatusOK).JSON(model.HttpResponse{
+		Code:    fiber.StatusOK,
+		Message: "logout successful",
+	})
+}
@@ -0,0 +1,24 @@
+package authHandler
+
+import (
+	"github.com/fbriansyah/go-modular/internal/model"
+	authModel "github.com/fbriansyah/go-modular/internal/model/auth"
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	sharedModule "github.com/fbriansyah/go-modular/internal/shared"
+	"github.com/gofiber/fiber/v2"
+)
+
+func (a *AuthHandler) Me(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	principal, ok := sharedModule.PrincipalFromContext(ctx)
+	if !ok {
+		return authModel.ErrUnauthenticated
+	}
+
+	return c.Status(fiber.StatusOK).JSON(model.HttpResponse{
+		Code:    fiber.StatusOK,
+		Message: "OK",
+		Data:    userModel.NewUserResponse(principal.User),
+	})
+}
@@ -0,0 +1,27 @@
+package authHandler
+
+import (
+	sharedModule "github.com/fbriansyah/go-modular/internal/shared"
+	"github.com/gofiber/fiber/v2"
+)
+
+// RequireAuth is a Fiber middleware that resolves the session from the cookie
+// or bearer token, rejects missing or expired sessions and stores the
+// principal on both the request locals and the user context.
+func (a *AuthHandler) RequireAuth(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	session, user, err := a.authService.Authenticate(ctx, a.extractToken(c))
+	if err != nil {
+		return err
+	}
+
+	principal := &sharedModule.Principal{
+		User:      user,
+		SessionID: session.ID,
+	}
+	c.Locals(sharedModule.PrincipalLocalsKey, principal)
+	c.SetUserContext(sharedModule.WithPrincipal(ctx, principal))
+
+	return c.Next()
+}
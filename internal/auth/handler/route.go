@@ -1 +1,22 @@
 package authHandler
+
+import (
+	sharedModule "github.com/fbriansyah/go-modular/internal/shared"
+	"github.com/gofiber/fiber/v2"
+)
+
+func (a *AuthHandler) SetupRoutes(httpApp *fiber.App) {
+	a.httpApp = httpApp
+
+	v1 := a.httpApp.Group("/v1")
+	a.setupAuthRoutes(v1)
+}
+
+func (a *AuthHandler) setupAuthRoutes(v1 fiber.Router) {
+	authGroup := v1.Group("/auth")
+	sharedModule.RegisterRoutes(authGroup, a.RequireAuth, []sharedModule.Route{
+		{Method: fiber.MethodPost, Path: "/login", Access: sharedModule.AccessAnonymous, Handler: a.Login},
+		{Method: fiber.MethodPost, Path: "/logout", Access: sharedModule.AccessAnonymous, Handler: a.Logout},
+		{Method: fiber.MethodGet, Path: "/me", Access: sharedModule.AccessAuthenticated, Handler: a.Me},
+	})
+}
@@ -0,0 +1,47 @@
+package authHandler
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// extractToken reads the session token from the session cookie or the
+// Authorization: Bearer header, in that order
+func (a *AuthHandler) extractToken(c *fiber.Ctx) string {
+	if token := c.Cookies(a.cookieName()); token != "" {
+		return token
+	}
+
+	header := c.Get(fiber.HeaderAuthorization)
+	if len(header) > 7 && strings.EqualFold(header[:7], "Bearer ") {
+		return strings.TrimSpace(header[7:])
+	}
+
+	return ""
+}
+
+func (a *AuthHandler) setSessionCookie(c *fiber.Ctx, token string, expiresAt time.Time) {
+	c.Cookie(&fiber.Cookie{
+		Name:     a.cookieName(),
+		Value:    token,
+		Path:     "/",
+		Expires:  expiresAt,
+		HTTPOnly: true,
+		Secure:   a.conf != nil && a.conf.Auth.CookieSecure,
+		SameSite: fiber.CookieSameSiteLaxMode,
+	})
+}
+
+func (a *AuthHandler) clearSessionCookie(c *fiber.Ctx) {
+	c.Cookie(&fiber.Cookie{
+		Name:     a.cookieName(),
+		Value:    "",
+		Path:     "/",
+		Expires:  time.Unix(0, 0),
+		HTTPOnly: true,
+		Secure:   a.conf != nil && a.conf.Auth.CookieSecure,
+		SameSite: fiber.CookieSameSiteLaxMode,
+	})
+}
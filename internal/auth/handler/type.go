@@ -0,0 +1,39 @@
+package authHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/fbriansyah/go-modular/config"
+	"github.com/fbriansyah/go-modular/constants"
+	authPort "github.com/fbriansyah/go-modular/ports/auth"
+)
+
+type Option func(*AuthHandler)
+
+type AuthHandler struct {
+	conf        *config.Config
+	httpApp     *fiber.App
+	authService authPort.AuthService
+}
+
+func NewAuthHandler(conf *config.Config, opts ...Option) *AuthHandler {
+	authHandler := &AuthHandler{conf: conf}
+	for _, opt := range opts {
+		opt(authHandler)
+	}
+	return authHandler
+}
+
+func WithAuthService(authService authPort.AuthService) Option {
+	return func(a *AuthHandler) {
+		a.authService = authService
+	}
+}
+
+// cookieName returns the configured session cookie name, falling back to the default
+func (a *AuthHandler) cookieName() string {
+	if a.conf != nil && a.conf.Auth.CookieName != "" {
+		return a.conf.Auth.CookieName
+	}
+	return constants.DefaultSessionCookieName
+}
@@ -1 +1,127 @@
 package authModule
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/fbriansyah/go-modular/config"
+	"github.com/fbriansyah/go-modular/constants"
+	authHandler "github.com/fbriansyah/go-modular/internal/auth/handler"
+	authRepository "github.com/fbriansyah/go-modular/internal/auth/repository"
+	authService "github.com/fbriansyah/go-modular/internal/auth/service"
+	sharedModule "github.com/fbriansyah/go-modular/internal/shared"
+	"github.com/fbriansyah/go-modular/pkg/database"
+	authPort "github.com/fbriansyah/go-modular/ports/auth"
+	userPort "github.com/fbriansyah/go-modular/ports/user"
+	"github.com/gofiber/fiber/v2"
+)
+
+type AuthModule struct {
+	conf        *config.Config
+	httpApp     *fiber.App
+	db          *database.DB
+	authHandler *authHandler.AuthHandler
+	cleanupJob  *sharedModule.PeriodicJob
+}
+
+func NewAuthModule() *AuthModule {
+	return &AuthModule{}
+}
+
+func (am *AuthModule) Name() string {
+	return "auth"
+}
+
+// DependsOn declares the user module, whose userPort.UserService verifies credentials
+func (am *AuthModule) DependsOn() []string {
+	return []string{"user"}
+}
+
+// Init wires the auth components, mounts the routes, subscribes to user
+// events and publishes authPort.AuthService and authPort.AuthMiddleware
+func (am *AuthModule) Init(deps *sharedModule.Dependencies) error {
+	am.conf = deps.Config
+	am.httpApp = deps.HTTPApp
+	am.db = deps.DB
+
+	userService, err := sharedModule.Resolve[userPort.UserService](deps.Services)
+	if err != nil {
+		return err
+	}
+
+	sessionRepo := authRepository.NewSessionRepository(am.db)
+	authService := authService.NewAuthService(
+		am.conf,
+		authService.WithSessionRepository(sessionRepo),
+		authService.WithUserService(userService),
+	)
+	am.authHandler = authHandler.NewAuthHandler(
+		am.conf,
+		authHandler.WithAuthService(authService),
+	)
+
+	if interval := am.cleanupInterval(); interval > 0 {
+		am.cleanupJob = sharedModule.NewPeriodicJob(
+			"auth.cleanup_expired_sessions",
+			interval,
+			authService.CleanupExpiredSessions,
+		)
+	}
+
+	// user events arrive through the outbox; a failed revocation is retried
+	sharedModule.Subscribe(deps.Events, "auth.revoke_sessions_on_update", authService.OnUserUpdated)
+	sharedModule.Subscribe(deps.Events, "auth.revoke_sessions_on_delete", authService.OnUserDeleted)
+
+	if err := sharedModule.Provide[authPort.AuthService](deps.Services, authService); err != nil {
+		return err
+	}
+	if err := sharedModule.Provide[authPort.AuthMiddleware](deps.Services, am.authHandler); err != nil {
+		return err
+	}
+
+	am.authHandler.SetupRoutes(am.httpApp)
+
+	return nil
+}
+
+// Start launches the background session cleanup job
+func (am *AuthModule) Start(ctx context.Context) error {
+	if am.cleanupJob != nil {
+		am.cleanupJob.Start(ctx)
+	}
+	return nil
+}
+
+// Stop stops the background session cleanup job
+func (am *AuthModule) Stop(ctx context.Context) error {
+	if am.cleanupJob == nil {
+		return nil
+	}
+	if err := am.cleanupJob.Stop(ctx); err != nil {
+		slog.Error("AuthModule", "message", "failed to stop session cleanup job", "error", err)
+		return err
+	}
+	return nil
+}
+
+// Health reports unhealthy when the session cleanup job should be running but is not
+func (am *AuthModule) Health(ctx context.Context) sharedModule.HealthStatus {
+	if am.authHandler == nil {
+		return sharedModule.Unhealthy("module is not initialized")
+	}
+	if am.cleanupJob != nil && !am.cleanupJob.Running() {
+		return sharedModule.Unhealthy("session cleanup job is not running")
+	}
+	return sharedModule.Healthy("")
+}
+
+// cleanupInterval returns the configured cleanup interval, falling back to the default
+func (am *AuthModule) cleanupInterval() time.Duration {
+	if am.conf == nil || am.conf.Auth.SessionCleanupInterval == 0 {
+		return constants.DefaultSessionCleanupInterval
+	}
+	return am.conf.Auth.SessionCleanupInterval
+}
+
+var _ sharedModule.DependentModule = (*AuthModule)(nil)
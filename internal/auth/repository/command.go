@@ -0,0 +1,105 @@
+package authRepository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	authModel "github.com/fbriansyah/go-modular/internal/model/auth"
+	"github.com/fbriansyah/go-modular/pkg/database"
+)
+
+// insertSessionQuery binds named parameters, so casts use CAST(... AS ...):
+// sqlx rewrites "::" to a literal ":" in named queries.
+const insertSessionQuery = `
+	INSERT INTO sessions (id, user_id, expires_at, created_at, ip_address, user_agent)
+	VALUES (:id, :user_id, :expires_at, :created_at, CAST(NULLIF(:ip_address, '') AS inet), NULLIF(:user_agent, ''))`
+
+// Create implements authPort.SessionRepository.
+// Subtle: this method shadows the method (BaseRepository).Create of SessionRepository.BaseRepository.
+func (s *SessionRepository) Create(ctx context.Context, entity *authModel.Session) (err error) {
+	defer s.Observe("Create", time.Now(), &err)
+
+	err = s.BaseRepository.Create(ctx, entity, insertSessionQuery)
+	if err != nil {
+		return s.handleError("Create", err)
+	}
+
+	return nil
+}
+
+// Delete implements authPort.SessionRepository.
+// Subtle: this method shadows the method (BaseRepository).Delete of SessionRepository.BaseRepository.
+func (s *SessionRepository) Delete(ctx context.Context, id string) (err error) {
+	defer s.Observe("Delete", time.Now(), &err)
+
+	query := `DELETE FROM sessions WHERE id = $1`
+
+	err = s.BaseRepository.Delete(ctx, id, query)
+	if err != nil {
+		return s.handleError("Delete", err)
+	}
+
+	return nil
+}
+
+// DeleteByUserID removes every session owned by the given user
+func (s *SessionRepository) DeleteByUserID(ctx context.Context, userID string) (err error) {
+	defer s.Observe("DeleteByUserID", time.Now(), &err)
+
+	query := `DELETE FROM sessions WHERE user_id = $1`
+
+	tx := database.GetTxFromContext(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query, userID)
+	} else {
+		_, err = s.GetDB().ExecContext(ctx, query, userID)
+	}
+
+	if err != nil {
+		return s.handleError("DeleteByUserID", err)
+	}
+
+	return nil
+}
+
+// DeleteByUserIDExcept removes every session owned by the given user except keepID
+func (s *SessionRepository) DeleteByUserIDExcept(ctx context.Context, userID, keepID string) (err error) {
+	defer s.Observe("DeleteByUserIDExcept", time.Now(), &err)
+
+	query := `DELETE FROM sessions WHERE user_id = $1 AND id <> $2`
+
+	tx := database.GetTxFromContext(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query, userID, keepID)
+	} else {
+		_, err = s.GetDB().ExecContext(ctx, query, userID, keepID)
+	}
+
+	if err != nil {
+		return s.handleError("DeleteByUserIDExcept", err)
+	}
+
+	return nil
+}
+
+// DeleteExpired calls cleanup_expired_sessions() while holding an advisory
+// lock so only one replica runs the cleanup at a time. It returns
+// database.ErrLockNotAcquired when another replica is already cleaning up.
+func (s *SessionRepository) DeleteExpired(ctx context.Context) (_ int64, err error) {
+	defer s.Observe("DeleteExpired", time.Now(), &err)
+
+	var deleted int64
+
+	err = database.WithAdvisoryLock(ctx, s.GetDB(), sessionCleanupLockKey, func(ctx context.Context) error {
+		return database.GetTxFromContext(ctx).GetContext(ctx, &deleted, "SELECT cleanup_expired_sessions()")
+	})
+	if err != nil {
+		if errors.Is(err, database.ErrLockNotAcquired) {
+			return 0, err
+		}
+		return 0, s.handleError("DeleteExpired", err)
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,71 @@
+package authRepository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	authModel "github.com/fbriansyah/go-modular/internal/model/auth"
+	"github.com/fbriansyah/go-modular/pkg/database/dbtest"
+	"github.com/fbriansyah/go-modular/utils"
+	"github.com/jmoiron/sqlx"
+)
+
+func TestInsertSessionQueryBinds(t *testing.T) {
+	session := authModel.NewSession("token", "user", time.Hour, "203.0.113.7", "test")
+
+	query, args, err := sqlx.Named(insertSessionQuery, session)
+	if err != nil {
+		t.Fatalf("bind named query: %v", err)
+	}
+	query = sqlx.Rebind(sqlx.DOLLAR, query)
+
+	if !strings.Contains(query, "CAST(NULLIF($5, '') AS inet)") {
+		t.Errorf("ip_address cast not preserved in bound query:\n%s", query)
+	}
+	if len(args) != 6 {
+		t.Errorf("got %d args, want 6", len(args))
+	}
+}
+
+func TestSessionRepositoryCreate(t *testing.T) {
+	db := dbtest.Open(t, "../../../migrations")
+	ctx := context.Background()
+	repo := NewSessionRepository(db)
+
+	userID := utils.GenerateUUID()
+	_, err := db.ExecContext(ctx,
+		`INSERT INTO users (id, email, password_hash, first_name, last_name) VALUES ($1, $2, 'x', 'Test', 'User')`,
+		userID, userID+"@example.com")
+	if err != nil {
+		t.Fatalf("insert user: %v", err)
+	}
+	t.Cleanup(func() { db.ExecContext(context.Background(), `DELETE FROM users WHERE id = $1`, userID) })
+
+	tests := []struct {
+		name      string
+		ipAddress string
+	}{
+		{"with ip address", "203.0.113.7"},
+		{"without ip address", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id := utils.GenerateUUID()
+			session := authModel.NewSession(id, userID, time.Hour, tt.ipAddress, "test-agent")
+
+			if err := repo.Create(ctx, session); err != nil {
+				t.Fatalf("Create: %v", err)
+			}
+
+			got, err := repo.GetByID(ctx, id)
+			if err != nil {
+				t.Fatalf("GetByID: %v", err)
+			}
+			if got.UserID != userID || got.IPAddress != tt.ipAddress || got.UserAgent != "test-agent" {
+				t.Errorf("got session %+v, want user %s, ip %q, agent test-agent", got, userID, tt.ipAddress)
+			}
+		})
+	}
+}
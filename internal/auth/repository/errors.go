@@ -0,0 +1,33 @@
+package authRepository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/fbriansyah/go-modular/pkg/database"
+	"github.com/lib/pq"
+)
+
+// handleError converts database errors to appropriate domain errors
+func (s *SessionRepository) handleError(operation string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, database.ErrNotFound) || err == sql.ErrNoRows {
+		return database.ErrNotFound
+	}
+
+	// Handle PostgreSQL specific errors
+	if pqErr, ok := err.(*pq.Error); ok {
+		switch pqErr.Code {
+		case "23505": // unique_violation
+			return database.NewDatabaseErrorWithCode(operation, "sessions", "23505", "duplicate key violation", database.ErrDuplicateKey)
+		case "23503": // foreign_key_violation
+			return database.NewDatabaseErrorWithCode(operation, "sessions", "23503", "user does not exist", database.ErrForeignKeyViolation)
+		}
+	}
+
+	// Wrap other errors
+	return database.NewDatabaseError(operation, "sessions", err)
+}
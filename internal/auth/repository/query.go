@@ -0,0 +1,28 @@
+package authRepository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	authModel "github.com/fbriansyah/go-modular/internal/model/auth"
+)
+
+var (
+	selectFields = "id, user_id, expires_at, created_at, COALESCE(host(ip_address), '') as ip_address, COALESCE(user_agent, '') as user_agent"
+)
+
+// GetByID implements authPort.SessionRepository.
+// Subtle: this method shadows the method (BaseRepository).GetByID of SessionRepository.BaseRepository.
+func (s *SessionRepository) GetByID(ctx context.Context, id string) (_ *authModel.Session, err error) {
+	defer s.Observe("GetByID", time.Now(), &err)
+
+	query := fmt.Sprintf("SELECT %s FROM sessions WHERE id = $1", selectFields)
+
+	session, err := s.BaseRepository.GetByID(ctx, id, query)
+	if err != nil {
+		return nil, s.handleError("GetByID", err)
+	}
+
+	return session, nil
+}
@@ -1 +1,22 @@
 package authRepository
+
+import (
+	authModel "github.com/fbriansyah/go-modular/internal/model/auth"
+	"github.com/fbriansyah/go-modular/pkg/database"
+	authPort "github.com/fbriansyah/go-modular/ports/auth"
+)
+
+// sessionCleanupLockKey guards cleanup_expired_sessions() across replicas
+var sessionCleanupLockKey = database.AdvisoryLockKey("auth.cleanup_expired_sessions")
+
+type SessionRepository struct {
+	*database.BaseRepository[authModel.Session, string]
+}
+
+func NewSessionRepository(db *database.DB) *SessionRepository {
+	return &SessionRepository{
+		BaseRepository: database.NewBaseRepository[authModel.Session, string](db, "sessions", "id"),
+	}
+}
+
+var _ authPort.SessionRepository = (*SessionRepository)(nil)
@@ -0,0 +1,51 @@
+package authService
+
+import (
+	"context"
+
+	authModel "github.com/fbriansyah/go-modular/internal/model/auth"
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	"github.com/fbriansyah/go-modular/pkg/database"
+	"github.com/fbriansyah/go-modular/pkg/logging"
+	"github.com/fbriansyah/go-modular/pkg/tracing"
+	"github.com/fbriansyah/go-modular/utils"
+)
+
+// Authenticate resolves the session behind token and loads its owner
+func (s *AuthService) Authenticate(ctx context.Context, token string) (_ *authModel.Session, _ *userModel.User, err error) {
+	ctx, span := tracing.Start(ctx, "AuthService.Authenticate")
+	defer span.EndWithError(&err)
+
+	if token == "" {
+		return nil, nil, authModel.ErrUnauthenticated
+	}
+
+	session, err := s.sessionRepository.GetByID(ctx, utils.HashToken(token))
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			return nil, nil, authModel.ErrUnauthenticated
+		}
+		return nil, nil, err
+	}
+
+	if session.IsExpired() {
+		if err := s.sessionRepository.Delete(ctx, session.ID); err != nil && !database.IsNotFoundError(err) {
+			logging.FromContext(ctx).Error("Authenticate", "message", "failed to delete expired session", "error", err)
+		}
+		return nil, nil, authModel.ErrSessionExpired
+	}
+
+	user, err := s.userService.GetUser(ctx, session.UserID)
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			return nil, nil, authModel.ErrUnauthenticated
+		}
+		return nil, nil, err
+	}
+
+	if user.Status != userModel.UserStatusActive {
+		return nil, nil, authModel.ErrUserInactive
+	}
+
+	return session, user, nil
+}
@@ -0,0 +1,29 @@
+package authService
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fbriansyah/go-modular/pkg/database"
+	"github.com/fbriansyah/go-modular/pkg/logging"
+	"github.com/fbriansyah/go-modular/pkg/tracing"
+)
+
+// CleanupExpiredSessions deletes expired sessions. It is a no-op when another
+// replica currently holds the cleanup lock.
+func (s *AuthService) CleanupExpiredSessions(ctx context.Context) (err error) {
+	ctx, span := tracing.Start(ctx, "AuthService.CleanupExpiredSessions")
+	defer span.EndWithError(&err)
+
+	deleted, err := s.sessionRepository.DeleteExpired(ctx)
+	if err != nil {
+		if errors.Is(err, database.ErrLockNotAcquired) {
+			logging.FromContext(ctx).Debug("CleanupExpiredSessions", "message", "skipped, cleanup running on another instance")
+			return nil
+		}
+		return err
+	}
+
+	logging.FromContext(ctx).Info("CleanupExpiredSessions", "deleted", deleted)
+	return nil
+}
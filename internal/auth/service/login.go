@@ -0,0 +1,59 @@
+package authService
+
+import (
+	"context"
+	"sync"
+
+	authModel "github.com/fbriansyah/go-modular/internal/model/auth"
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	"github.com/fbriansyah/go-modular/pkg/database"
+	"github.com/fbriansyah/go-modular/pkg/tracing"
+	"github.com/fbriansyah/go-modular/utils"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// dummyPasswordHash is compared against when no user has the login email
+var dummyPasswordHash = sync.OnceValue(func() []byte {
+	hash, _ := bcrypt.GenerateFromPassword([]byte("dummy-password"), bcrypt.DefaultCost)
+	return hash
+})
+
+func (s *AuthService) Login(ctx context.Context, req *authModel.LoginRequest) (_ *authModel.LoginResponse, err error) {
+	ctx, span := tracing.Start(ctx, "AuthService.Login")
+	defer span.EndWithError(&err)
+
+	user, err := s.userService.GetUserByEmail(ctx, req.Email)
+	if err != nil {
+		if database.IsNotFoundError(err) {
+			// spend the same bcrypt work as a wrong password so timing does not reveal registered emails
+			bcrypt.CompareHashAndPassword(dummyPasswordHash(), []byte(req.Password))
+			return nil, authModel.ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	if !user.CheckPassword(req.Password) {
+		return nil, authModel.ErrInvalidCredentials
+	}
+
+	if user.Status != userModel.UserStatusActive {
+		return nil, authModel.ErrUserInactive
+	}
+
+	token, err := utils.GenerateToken(32)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the token's hash is stored, so a leaked sessions row is not a usable credential
+	session := authModel.NewSession(utils.HashToken(token), user.ID, s.sessionTTL(), req.IPAddress, req.UserAgent)
+	if err := s.sessionRepository.Create(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return &authModel.LoginResponse{
+		Token:     token,
+		ExpiresAt: session.ExpiresAt,
+		User:      userModel.NewUserResponse(user),
+	}, nil
+}
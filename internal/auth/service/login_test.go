@@ -0,0 +1,142 @@
+package authService
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	authModel "github.com/fbriansyah/go-modular/internal/model/auth"
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	"github.com/fbriansyah/go-modular/pkg/database"
+	userPort "github.com/fbriansyah/go-modular/ports/user"
+	"github.com/fbriansyah/go-modular/utils"
+)
+
+// fakeUserService resolves GetUserByEmail and GetUser from a fixed user list
+type fakeUserService struct {
+	userPort.UserService
+	users []*userModel.User
+}
+
+func (f *fakeUserService) GetUserByEmail(_ context.Context, email string) (*userModel.User, error) {
+	for _, u := range f.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return nil, database.ErrNotFound
+}
+
+func (f *fakeUserService) GetUser(_ context.Context, id string) (*userModel.User, error) {
+	for _, u := range f.users {
+		if u.ID == id {
+			return u, nil
+		}
+	}
+	return nil, database.ErrNotFound
+}
+
+// fakeSessionRepository keeps sessions in memory, keyed by ID
+type fakeSessionRepository struct {
+	sessions map[string]*authModel.Session
+}
+
+func (f *fakeSessionRepository) Create(_ context.Context, session *authModel.Session) error {
+	f.sessions[session.ID] = session
+	return nil
+}
+
+func (f *fakeSessionRepository) GetByID(_ context.Context, id string) (*authModel.Session, error) {
+	session, ok := f.sessions[id]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return session, nil
+}
+
+func (f *fakeSessionRepository) Delete(_ context.Context, id string) error {
+	if _, ok := f.sessions[id]; !ok {
+		return database.ErrNotFound
+	}
+	delete(f.sessions, id)
+	return nil
+}
+
+func (f *fakeSessionRepository) DeleteByUserID(_ context.Context, userID string) error {
+	for id, session := range f.sessions {
+		if session.UserID == userID {
+			delete(f.sessions, id)
+		}
+	}
+	return nil
+}
+
+func (f *fakeSessionRepository) DeleteByUserIDExcept(_ context.Context, userID, keepID string) error {
+	for id, session := range f.sessions {
+		if session.UserID == userID && id != keepID {
+			delete(f.sessions, id)
+		}
+	}
+	return nil
+}
+func (f *fakeSessionRepository) DeleteExpired(context.Context) (int64, error) { return 0, nil }
+
+func newTestAuthService(t *testing.T) (*AuthService, *fakeSessionRepository) {
+	t.Helper()
+
+	user, err := userModel.NewUser(utils.GenerateUUID(), "jane@example.com", "Secret123!", "Jane", "Doe")
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+
+	sessions := &fakeSessionRepository{sessions: map[string]*authModel.Session{}}
+	service := NewAuthService(nil,
+		WithUserService(&fakeUserService{users: []*userModel.User{user}}),
+		WithSessionRepository(sessions),
+	)
+	return service, sessions
+}
+
+func TestLoginStoresTokenHash(t *testing.T) {
+	service, sessions := newTestAuthService(t)
+	ctx := context.Background()
+
+	resp, err := service.Login(ctx, &authModel.LoginRequest{Email: "jane@example.com", Password: "Secret123!"})
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	if _, ok := sessions.sessions[resp.Token]; ok {
+		t.Fatal("session stored under the plaintext token")
+	}
+	if _, ok := sessions.sessions[utils.HashToken(resp.Token)]; !ok {
+		t.Fatal("session not stored under the token hash")
+	}
+
+	if _, _, err := service.Authenticate(ctx, resp.Token); err != nil {
+		t.Fatalf("Authenticate with issued token: %v", err)
+	}
+	if _, _, err := service.Authenticate(ctx, utils.HashToken(resp.Token)); !errors.Is(err, authModel.ErrUnauthenticated) {
+		t.Fatalf("Authenticate with stored hash: got %v, want ErrUnauthenticated", err)
+	}
+
+	if err := service.Logout(ctx, resp.Token); err != nil {
+		t.Fatalf("Logout: %v", err)
+	}
+	if len(sessions.sessions) != 0 {
+		t.Fatalf("Logout left %d sessions", len(sessions.sessions))
+	}
+}
+
+func TestLoginRejectsUnknownEmailAndWrongPassword(t *testing.T) {
+	service, _ := newTestAuthService(t)
+
+	for _, req := range []*authModel.LoginRequest{
+		{Email: "nobody@example.com", Password: "Secret123!"},
+		{Email: "jane@example.com", Password: "Wrong123!"},
+	} {
+		if _, err := service.Login(context.Background(), req); !errors.Is(err, authModel.ErrInvalidCredentials) {
+			t.Errorf("Login(%s): got %v, want ErrInvalidCredentials", req.Email, err)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package authService
+
+import (
+	"context"
+
+	"github.com/fbriansyah/go-modular/pkg/database"
+	"github.com/fbriansyah/go-modular/pkg/tracing"
+	"github.com/fbriansyah/go-modular/utils"
+)
+
+// Logout removes the session behind token. Logging out an unknown session is not an error.
+func (s *AuthService) Logout(ctx context.Context, token string) (err error) {
+	ctx, span := tracing.Start(ctx, "AuthService.Logout")
+	defer span.EndWithError(&err)
+
+	if token == "" {
+		return nil
+	}
+
+	err = s.sessionRepository.Delete(ctx, utils.HashToken(token))
+	if err != nil && !database.IsNotFoundError(err) {
+		return err
+	}
+
+	return nil
+}
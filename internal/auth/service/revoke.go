@@ -0,0 +1,53 @@
+package authService
+
+import (
+	"context"
+
+	"github.com/fbriansyah/go-modular/pkg/logging"
+	"github.com/fbriansyah/go-modular/pkg/tracing"
+	userPort "github.com/fbriansyah/go-modular/ports/user"
+)
+
+// RevokeUserSessions removes every session of the given user, signing them out everywhere
+func (s *AuthService) RevokeUserSessions(ctx context.Context, userID string) (err error) {
+	ctx, span := tracing.Start(ctx, "AuthService.RevokeUserSessions")
+	defer span.EndWithError(&err)
+
+	if err := s.sessionRepository.DeleteByUserID(ctx, userID); err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("RevokeUserSessions", "user_id", userID)
+	return nil
+}
+
+// RevokeOtherUserSessions removes every session of the given user except
+// keepSessionID, signing them out everywhere else
+func (s *AuthService) RevokeOtherUserSessions(ctx context.Context, userID, keepSessionID string) (err error) {
+	ctx, span := tracing.Start(ctx, "AuthService.RevokeOtherUserSessions")
+	defer span.EndWithError(&err)
+
+	if err := s.sessionRepository.DeleteByUserIDExcept(ctx, userID, keepSessionID); err != nil {
+		return err
+	}
+
+	logging.FromContext(ctx).Info("RevokeOtherUserSessions", "user_id", userID)
+	return nil
+}
+
+// OnUserUpdated revokes every session of a user who is no longer active, and
+// every other session of a user whose password changed
+func (s *AuthService) OnUserUpdated(ctx context.Context, event userPort.UserUpdated) error {
+	switch {
+	case event.Deactivated():
+		return s.RevokeUserSessions(ctx, event.UserID)
+	case event.PasswordChanged:
+		return s.RevokeOtherUserSessions(ctx, event.UserID, event.SessionID)
+	}
+	return nil
+}
+
+// OnUserDeleted revokes the sessions of a deleted user
+func (s *AuthService) OnUserDeleted(ctx context.Context, event userPort.UserDeleted) error {
+	return s.RevokeUserSessions(ctx, event.UserID)
+}
@@ -0,0 +1,89 @@
+package authService
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	authModel "github.com/fbriansyah/go-modular/internal/model/auth"
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	userPort "github.com/fbriansyah/go-modular/ports/user"
+)
+
+func TestOnUserUpdatedRevokesOtherSessionsOnPasswordChange(t *testing.T) {
+	service, sessions := newTestAuthService(t)
+	ctx := context.Background()
+
+	for _, s := range []*authModel.Session{
+		authModel.NewSession("current", "alice", time.Hour, "", ""),
+		authModel.NewSession("stolen", "alice", time.Hour, "", ""),
+		authModel.NewSession("bob", "bob", time.Hour, "", ""),
+	} {
+		sessions.sessions[s.ID] = s
+	}
+
+	// an update that neither deactivates nor changes the password keeps every session
+	if err := service.OnUserUpdated(ctx, userPort.UserUpdated{
+		UserID:         "alice",
+		Status:         userModel.UserStatusActive,
+		PreviousStatus: userModel.UserStatusActive,
+		SessionID:      "current",
+	}); err != nil {
+		t.Fatalf("OnUserUpdated: %v", err)
+	}
+	if len(sessions.sessions) != 3 {
+		t.Fatalf("profile update revoked sessions: %d left, want 3", len(sessions.sessions))
+	}
+
+	if err := service.OnUserUpdated(ctx, userPort.UserUpdated{
+		UserID:          "alice",
+		Status:          userModel.UserStatusActive,
+		PreviousStatus:  userModel.UserStatusActive,
+		PasswordChanged: true,
+		SessionID:       "current",
+	}); err != nil {
+		t.Fatalf("OnUserUpdated: %v", err)
+	}
+
+	if _, ok := sessions.sessions["stolen"]; ok {
+		t.Error("password change kept another session of the user")
+	}
+	if _, ok := sessions.sessions["current"]; !ok {
+		t.Error("password change revoked the session that made it")
+	}
+	if _, ok := sessions.sessions["bob"]; !ok {
+		t.Error("password change revoked another user's session")
+	}
+}
+
+func TestOnUserUpdatedRevokesAllSessionsOnDeactivation(t *testing.T) {
+	for _, status := range []userModel.UserStatus{userModel.UserStatusInactive, userModel.UserStatusSuspended} {
+		t.Run(string(status), func(t *testing.T) {
+			service, sessions := newTestAuthService(t)
+
+			for _, s := range []*authModel.Session{
+				authModel.NewSession("current", "alice", time.Hour, "", ""),
+				authModel.NewSession("other", "alice", time.Hour, "", ""),
+				authModel.NewSession("bob", "bob", time.Hour, "", ""),
+			} {
+				sessions.sessions[s.ID] = s
+			}
+
+			if err := service.OnUserUpdated(context.Background(), userPort.UserUpdated{
+				UserID:         "alice",
+				Status:         status,
+				PreviousStatus: userModel.UserStatusActive,
+				SessionID:      "current",
+			}); err != nil {
+				t.Fatalf("OnUserUpdated: %v", err)
+			}
+
+			if len(sessions.sessions) != 1 {
+				t.Fatalf("got %d sessions left, want only bob's", len(sessions.sessions))
+			}
+			if _, ok := sessions.sessions["bob"]; !ok {
+				t.Error("deactivation revoked another user's session")
+			}
+		})
+	}
+}
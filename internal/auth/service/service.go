@@ -1 +1,48 @@
 package authService
+
+import (
+	"time"
+
+	"github.com/fbriansyah/go-modular/config"
+	"github.com/fbriansyah/go-modular/constants"
+	authPort "github.com/fbriansyah/go-modular/ports/auth"
+	userPort "github.com/fbriansyah/go-modular/ports/user"
+)
+
+type AuthService struct {
+	conf              *config.Config
+	sessionRepository authPort.SessionRepository
+	userService       userPort.UserService
+}
+
+type Option func(*AuthService)
+
+func NewAuthService(conf *config.Config, opts ...Option) *AuthService {
+	authService := &AuthService{conf: conf}
+	for _, opt := range opts {
+		opt(authService)
+	}
+	return authService
+}
+
+func WithSessionRepository(sessionRepository authPort.SessionRepository) Option {
+	return func(a *AuthService) {
+		a.sessionRepository = sessionRepository
+	}
+}
+
+func WithUserService(userService userPort.UserService) Option {
+	return func(a *AuthService) {
+		a.userService = userService
+	}
+}
+
+// sessionTTL returns the configured session lifetime, falling back to the default
+func (s *AuthService) sessionTTL() time.Duration {
+	if s.conf != nil && s.conf.Auth.SessionTTL > 0 {
+		return s.conf.Auth.SessionTTL
+	}
+	return constants.DefaultSessionTTL
+}
+
+var _ authPort.AuthService = (*AuthService)(nil)
@@ -0,0 +1,29 @@
+package authModel
+
+import "time"
+
+func NewSession(id, userID string, ttl time.Duration, ipAddress, userAgent string) *Session {
+	now := time.Now()
+	return &Session{
+		ID:        id,
+		UserID:    userID,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+	}
+}
+
+type Session struct {
+	ID        string    `json:"-" db:"id"` // SHA-256 of the session token, never serialized
+	UserID    string    `json:"user_id" db:"user_id"`
+	ExpiresAt time.Time `json:"expires_at" db:"expires_at"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	IPAddress string    `json:"ip_address" db:"ip_address"`
+	UserAgent string    `json:"user_agent" db:"user_agent"`
+}
+
+// IsExpired reports whether the session is no longer valid
+func (s *Session) IsExpired() bool {
+	return !time.Now().Before(s.ExpiresAt)
+}
@@ -0,0 +1,18 @@
+package authModel
+
+import "github.com/fbriansyah/go-modular/internal/model"
+
+// Every error here matches model.ErrUnauthorized and is reported as 401
+var (
+	// ErrInvalidCredentials is returned when the email or password does not match
+	ErrInvalidCredentials = model.NewUnauthorizedError("invalid email or password")
+
+	// ErrUnauthenticated is returned when no valid session is attached to the request
+	ErrUnauthenticated = model.NewUnauthorizedError("authentication required")
+
+	// ErrSessionExpired is returned when the session exists but has expired
+	ErrSessionExpired = model.NewUnauthorizedError("session expired")
+
+	// ErrUserInactive is returned when the session owner is not an active user
+	ErrUserInactive = model.NewUnauthorizedError("user is not active")
+)
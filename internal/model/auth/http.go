@@ -0,0 +1,20 @@
+package authModel
+
+import (
+	"time"
+
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+)
+
+type LoginRequest struct {
+	Email     string `json:"email"`
+	Password  string `json:"password"`
+	IPAddress string `json:"-"`
+	UserAgent string `json:"-"`
+}
+
+type LoginResponse struct {
+	Token     string                  `json:"token"`
+	ExpiresAt time.Time               `json:"expires_at"`
+	User      *userModel.UserResponse `json:"user"`
+}
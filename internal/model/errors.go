@@ -0,0 +1,25 @@
+package model
+
+import "errors"
+
+// ErrUnauthorized matches every error created with NewUnauthorizedError, so
+// callers can map them to 401 Unauthorized without knowing the module
+var ErrUnauthorized = errors.New("unauthorized")
+
+// unauthorizedError keeps its own message while matching ErrUnauthorized
+type unauthorizedError struct {
+	message string
+}
+
+func (e *unauthorizedError) Error() string {
+	return e.message
+}
+
+func (e *unauthorizedError) Is(target error) bool {
+	return target == ErrUnauthorized
+}
+
+// NewUnauthorizedError returns an error with message that matches ErrUnauthorized
+func NewUnauthorizedError(message string) error {
+	return &unauthorizedError{message: message}
+}
@@ -6,7 +6,43 @@ type HttpResponse struct {
 	Data    any    `json:"data,omitempty"`
 }
 
+// ListResponse is the HttpResponse envelope for paginated collections
+type ListResponse[T any] struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Items   []T    `json:"items"`
+	Total   int64  `json:"total"`
+	Limit   int    `json:"limit"`
+	Offset  int    `json:"offset"`
+	Next    string `json:"next,omitempty"`
+	Prev    string `json:"prev,omitempty"`
+}
+
+// CursorListResponse is the HttpResponse envelope for keyset-paginated
+// collections. An empty NextCursor means there are no more items.
+type CursorListResponse[T any] struct {
+	Code       int    `json:"code"`
+	Message    string `json:"message"`
+	Items      []T    `json:"items"`
+	Limit      int    `json:"limit"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
 type GeneralListQuery struct {
 	Limit  int `json:"limit"`
 	Offset int `json:"offset"`
 }
+
+// Normalize applies defaultLimit when no limit was given, clamps the limit to
+// maxLimit and rejects negative offsets
+func (q *GeneralListQuery) Normalize(defaultLimit, maxLimit int) {
+	if q.Limit <= 0 {
+		q.Limit = defaultLimit
+	}
+	if maxLimit > 0 && q.Limit > maxLimit {
+		q.Limit = maxLimit
+	}
+	if q.Offset < 0 {
+		q.Offset = 0
+	}
+}
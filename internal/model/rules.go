@@ -0,0 +1,119 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"unicode/utf8"
+)
+
+// Validation error codes shared by the built-in rules
+const (
+	CodeRequired      = "required"
+	CodeMinLength     = "min_length"
+	CodeMaxLength     = "max_length"
+	CodeInvalidFormat = "invalid_format"
+	CodeInvalidEmail  = "invalid_email"
+	CodeNotAllowed    = "not_allowed"
+	CodeInvalid       = "invalid"
+)
+
+var emailRegex = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
+
+// Rule checks a single value and returns a failure, or nil when the value is valid
+type Rule func(field, value string) *ValidationError
+
+// FieldRules binds a value to the rules it must satisfy
+type FieldRules struct {
+	Field string
+	Value string
+	Rules []Rule
+}
+
+// Field declares the rules for one field
+func Field(name, value string, rules ...Rule) FieldRules {
+	return FieldRules{Field: name, Value: value, Rules: rules}
+}
+
+// Validate runs every rule of every field and collects all failures.
+// Empty values are only checked by Required, so optional fields can reuse
+// the same rules; a field that fails Required is not checked further.
+// It returns a ValidationErrors, or nil when everything is valid.
+func Validate(fields ...FieldRules) error {
+	var errs ValidationErrors
+
+	for _, field := range fields {
+		if field.Value == "" {
+			for _, rule := range field.Rules {
+				if failure := rule(field.Field, field.Value); failure != nil && failure.Code == CodeRequired {
+					errs.Errors = append(errs.Errors, *failure)
+					break
+				}
+			}
+			continue
+		}
+
+		for _, rule := range field.Rules {
+			if failure := rule(field.Field, field.Value); failure != nil {
+				errs.Errors = append(errs.Errors, *failure)
+			}
+		}
+	}
+
+	return errs.Err()
+}
+
+// Required fails on empty values
+func Required() Rule {
+	return func(field, value string) *ValidationError {
+		if value == "" {
+			return &ValidationError{Field: field, Code: CodeRequired, Message: fmt.Sprintf("%s cannot be empty", field)}
+		}
+		return nil
+	}
+}
+
+// MinLength fails when the value has fewer than n characters
+func MinLength(n int) Rule {
+	return func(field, value string) *ValidationError {
+		if utf8.RuneCountInString(value) < n {
+			return &ValidationError{Field: field, Code: CodeMinLength, Message: fmt.Sprintf("%s must be at least %d characters long", field, n)}
+		}
+		return nil
+	}
+}
+
+// MaxLength fails when the value has more than n characters
+func MaxLength(n int) Rule {
+	return func(field, value string) *ValidationError {
+		if utf8.RuneCountInString(value) > n {
+			return &ValidationError{Field: field, Code: CodeMaxLength, Message: fmt.Sprintf("%s cannot exceed %d characters", field, n)}
+		}
+		return nil
+	}
+}
+
+// Matches fails when the value does not match re
+func Matches(re *regexp.Regexp, code, message string) Rule {
+	return func(field, value string) *ValidationError {
+		if !re.MatchString(value) {
+			return &ValidationError{Field: field, Code: code, Message: message}
+		}
+		return nil
+	}
+}
+
+// Email fails when the value is not a plausible email address
+func Email() Rule {
+	return Matches(emailRegex, CodeInvalidEmail, "invalid email format")
+}
+
+// OneOf fails when the value is not one of allowed
+func OneOf(allowed ...string) Rule {
+	return func(field, value string) *ValidationError {
+		if !slices.Contains(allowed, value) {
+			return &ValidationError{Field: field, Code: CodeNotAllowed, Message: fmt.Sprintf("%s must be one of %v", field, allowed)}
+		}
+		return nil
+	}
+}
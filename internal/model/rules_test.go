@@ -0,0 +1,108 @@
+package model
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestRules(t *testing.T) {
+	slug := regexp.MustCompile(`^[a-z-]+$`)
+
+	tests := []struct {
+		name     string
+		rule     Rule
+		value    string
+		wantCode string
+	}{
+		{"required empty", Required(), "", CodeRequired},
+		{"required set", Required(), "x", ""},
+		{"required whitespace", Required(), " ", ""},
+
+		{"min length short", MinLength(3), "ab", CodeMinLength},
+		{"min length exact", MinLength(3), "abc", ""},
+		{"min length counts runes", MinLength(3), "héé", ""},
+		{"min length multibyte short", MinLength(3), "日本", CodeMinLength},
+
+		{"max length long", MaxLength(3), "abcd", CodeMaxLength},
+		{"max length exact", MaxLength(3), "abc", ""},
+		{"max length counts runes", MaxLength(3), "日本語", ""},
+
+		{"matches", Matches(slug, CodeInvalidFormat, "lowercase letters only"), "go-modular", ""},
+		{"does not match", Matches(slug, CodeInvalidFormat, "lowercase letters only"), "Go_Modular", CodeInvalidFormat},
+
+		{"email", Email(), "jane.doe+tag@example.co.uk", ""},
+		{"email without at", Email(), "jane.example.com", CodeInvalidEmail},
+		{"email without domain dot", Email(), "jane@example", CodeInvalidEmail},
+		{"email with space", Email(), "jane doe@example.com", CodeInvalidEmail},
+		{"email short tld", Email(), "jane@example.c", CodeInvalidEmail},
+
+		{"one of allowed", OneOf("active", "inactive"), "inactive", ""},
+		{"one of not allowed", OneOf("active", "inactive"), "deleted", CodeNotAllowed},
+		{"one of is case sensitive", OneOf("active", "inactive"), "Active", CodeNotAllowed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			failure := tt.rule("field", tt.value)
+			if tt.wantCode == "" {
+				if failure != nil {
+					t.Fatalf("rule(%q) = %+v, want no failure", tt.value, failure)
+				}
+				return
+			}
+			if failure == nil {
+				t.Fatalf("rule(%q) passed, want %s", tt.value, tt.wantCode)
+			}
+			if failure.Field != "field" || failure.Code != tt.wantCode || failure.Message == "" {
+				t.Fatalf("rule(%q) = %+v, want field %q code %s and a message", tt.value, failure, "field", tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestValidateCollectsEveryFieldError(t *testing.T) {
+	err := Validate(
+		Field("first_name", "", Required(), MaxLength(5)),
+		Field("last_name", "a much too long name", Required(), MinLength(2), MaxLength(5)),
+		Field("email", "not-an-email", Required(), Email()),
+		Field("status", "deleted", OneOf("active", "inactive")),
+		Field("nickname", "", MinLength(3)),
+		Field("password", "x", Required(), MinLength(8), Matches(regexp.MustCompile(`[0-9]`), CodeInvalidFormat, "password must contain a digit")),
+		Field("city", "Jakarta", Required(), MaxLength(20)),
+	)
+
+	var errs ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Validate returned %T (%v), want ValidationErrors", err, err)
+	}
+
+	type failure struct{ field, code string }
+	var got []failure
+	for _, e := range errs.Errors {
+		got = append(got, failure{e.Field, e.Code})
+	}
+	want := []failure{
+		// an empty required field is not checked further
+		{"first_name", CodeRequired},
+		{"last_name", CodeMaxLength},
+		{"email", CodeInvalidEmail},
+		{"status", CodeNotAllowed},
+		// an empty optional field is skipped
+		{"password", CodeMinLength},
+		{"password", CodeInvalidFormat},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Validate failures = %v, want %v", got, want)
+	}
+}
+
+func TestValidateValid(t *testing.T) {
+	err := Validate(
+		Field("email", "jane@example.com", Required(), Email()),
+		Field("nickname", "", MinLength(3), MaxLength(10)),
+	)
+	if err != nil {
+		t.Fatalf("Validate = %v, want nil", err)
+	}
+}
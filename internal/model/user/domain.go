@@ -1,15 +1,33 @@
 package userModel
 
 import (
-	"errors"
 	"fmt"
 	"regexp"
 	"time"
 
-	"github.com/fbriansyah/go-modular/utils"
+	"github.com/fbriansyah/go-modular/internal/model"
 	"golang.org/x/crypto/bcrypt"
 )
 
+var (
+	// Check for valid characters (letters, spaces, hyphens, apostrophes)
+	nameRegex = regexp.MustCompile(`^[a-zA-Z\s\-']+$`)
+
+	upperRegex = regexp.MustCompile(`[A-Z]`)
+	lowerRegex = regexp.MustCompile(`[a-z]`)
+	digitRegex = regexp.MustCompile(`\d`)
+)
+
+// passwordRules are the password requirements; every unmet rule is reported
+var passwordRules = []model.Rule{
+	model.Required(),
+	model.MinLength(8),
+	model.MaxLength(128),
+	model.Matches(upperRegex, "password_uppercase", "password must contain at least one uppercase letter"),
+	model.Matches(lowerRegex, "password_lowercase", "password must contain at least one lowercase letter"),
+	model.Matches(digitRegex, "password_digit", "password must contain at least one digit"),
+}
+
 func NewUser(id, email, password, firstName, lastName string) (*User, error) {
 	user := &User{
 		ID:        id,
@@ -17,13 +35,20 @@ func NewUser(id, email, password, firstName, lastName string) (*User, error) {
 		FirstName: firstName,
 		LastName:  lastName,
 		Status:    UserStatusActive,
+		Role:      UserRoleUser,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 		Version:   1,
 	}
-	if err := user.Validate(); err != nil {
-		return nil, err
+
+	// report profile and password problems together
+	var errs model.ValidationErrors
+	errs.Merge("user", user.Validate())
+	errs.Merge("password", ValidatePassword(password))
+	if errs.HasErrors() {
+		return nil, errs
 	}
+
 	if err := user.SetPassword(password); err != nil {
 		return nil, err
 	}
@@ -35,13 +60,19 @@ type User struct {
 	FirstName string     `json:"first_name" db:"first_name"`
 	LastName  string     `json:"last_name" db:"last_name"`
 	Email     string     `json:"email" db:"email"`
-	Password  string     `json:"password" db:"password"`
+	Password  string     `json:"-" db:"password"` // never serialized, see UserResponse
 	Status    UserStatus `json:"status" db:"status"`
+	Role      UserRole   `json:"role" db:"role"`
 	CreatedAt time.Time  `json:"created_at" db:"created_at"`
 	UpdatedAt time.Time  `json:"updated_at" db:"updated_at"`
 	Version   int        `json:"version" db:"version"` // Optimistic locking
 }
 
+// IsAdmin reports whether the user holds the admin role
+func (u *User) IsAdmin() bool {
+	return u.Role == UserRoleAdmin
+}
+
 // CheckPassword verifies if the provided password matches the user's password
 func (u *User) CheckPassword(password string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password))
@@ -49,7 +80,7 @@ func (u *User) CheckPassword(password string) bool {
 }
 
 func (u *User) SetPassword(password string) error {
-	if err := utils.ValidatePassword(password); err != nil {
+	if err := ValidatePassword(password); err != nil {
 		return err
 	}
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
@@ -60,69 +91,38 @@ func (u *User) SetPassword(password string) error {
 	return nil
 }
 
-// Validate performs comprehensive validation of the User aggregate
+// Validate performs comprehensive validation of the User aggregate and
+// returns every failure as model.ValidationErrors
 func (u *User) Validate() error {
-	if u.ID == "" {
-		return errors.New("user ID cannot be empty")
-	}
-
-	if err := u.validateEmail(); err != nil {
-		return err
-	}
-
-	if err := u.validateName(); err != nil {
-		return err
-	}
-
-	return nil
+	return model.Validate(
+		model.Field("id", u.ID, model.Required()),
+		model.Field("email", u.Email,
+			model.Required(),
+			model.Email(),
+			model.MaxLength(255),
+		),
+		model.Field("first_name", u.FirstName,
+			model.Required(),
+			model.MaxLength(100),
+			model.Matches(nameRegex, model.CodeInvalidFormat, "first name contains invalid characters"),
+		),
+		model.Field("last_name", u.LastName,
+			model.Required(),
+			model.MaxLength(100),
+			model.Matches(nameRegex, model.CodeInvalidFormat, "last name contains invalid characters"),
+		),
+		model.Field("status", string(u.Status),
+			model.Required(),
+			model.OneOf(string(UserStatusActive), string(UserStatusInactive), string(UserStatusSuspended)),
+		),
+		model.Field("role", string(u.Role),
+			model.Required(),
+			model.OneOf(string(UserRoleUser), string(UserRoleAdmin)),
+		),
+	)
 }
 
-// validateEmail validates the email format and requirements
-func (u *User) validateEmail() error {
-	if u.Email == "" {
-		return errors.New("email cannot be empty")
-	}
-
-	// Basic email regex pattern
-	emailRegex := regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
-	if !emailRegex.MatchString(u.Email) {
-		return errors.New("invalid email format")
-	}
-
-	if len(u.Email) > 255 {
-		return errors.New("email cannot exceed 255 characters")
-	}
-
-	return nil
-}
-
-// validateName validates first and last name requirements
-func (u *User) validateName() error {
-	if u.FirstName == "" {
-		return errors.New("first name cannot be empty")
-	}
-
-	if u.LastName == "" {
-		return errors.New("last name cannot be empty")
-	}
-
-	if len(u.FirstName) > 100 {
-		return errors.New("first name cannot exceed 100 characters")
-	}
-
-	if len(u.LastName) > 100 {
-		return errors.New("last name cannot exceed 100 characters")
-	}
-
-	// Check for valid characters (letters, spaces, hyphens, apostrophes)
-	nameRegex := regexp.MustCompile(`^[a-zA-Z\s\-']+$`)
-	if !nameRegex.MatchString(u.FirstName) {
-		return errors.New("first name contains invalid characters")
-	}
-
-	if !nameRegex.MatchString(u.LastName) {
-		return errors.New("last name contains invalid characters")
-	}
-
-	return nil
+// ValidatePassword checks password against every password requirement
+func ValidatePassword(password string) error {
+	return model.Validate(model.Field("password", password, passwordRules...))
 }
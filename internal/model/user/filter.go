@@ -0,0 +1,41 @@
+package userModel
+
+import (
+	"time"
+
+	"github.com/fbriansyah/go-modular/pkg/database"
+)
+
+// SortableFields whitelists the fields users can be sorted by, mapped to their SQL columns
+var SortableFields = map[string]string{
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+	"first_name": "first_name",
+	"last_name":  "last_name",
+	"email":      "email",
+	"status":     "status",
+}
+
+// UserFilter holds the criteria used to search users
+type UserFilter struct {
+	FirstName     string
+	LastName      string
+	Email         string
+	Status        UserStatus
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	Sort          []database.SortField
+}
+
+// NewUserFilter builds a filter from the populated fields of user
+func NewUserFilter(user *User) *UserFilter {
+	if user == nil {
+		return &UserFilter{}
+	}
+	return &UserFilter{
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Email:     user.Email,
+		Status:    user.Status,
+	}
+}
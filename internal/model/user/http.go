@@ -1,6 +1,10 @@
 package userModel
 
-import "github.com/fbriansyah/go-modular/internal/model"
+import (
+	"time"
+
+	"github.com/fbriansyah/go-modular/internal/model"
+)
 
 type CreateUserRequest struct {
 	FirstName string `json:"first_name"`
@@ -11,7 +15,36 @@ type CreateUserRequest struct {
 
 type ListUserQuery struct {
 	model.GeneralListQuery
-	FirstName string `json:"first_name"`
-	LastName  string `json:"last_name"`
-	Email     string `json:"email"`
+	FirstName     string     `json:"first_name"`
+	LastName      string     `json:"last_name"`
+	Email         string     `json:"email"`
+	Status        UserStatus `json:"status"`
+	CreatedAfter  *time.Time `json:"created_after"`
+	CreatedBefore *time.Time `json:"created_before"`
+	Sort          string     `json:"sort"`
+	Cursor        string     `json:"cursor"`
+}
+
+// UpdateUserRequest is a partial update; nil fields are left unchanged.
+// Version must match the stored version, otherwise the update is rejected.
+// Changing Password requires CurrentPassword and signs out every session
+// except SessionID, the one making the request. Users cannot change their
+// own status.
+type UpdateUserRequest struct {
+	ID              string  `json:"-"`
+	SessionID       string  `json:"-"`
+	FirstName       *string `json:"first_name"`
+	LastName        *string `json:"last_name"`
+	Email           *string `json:"email"`
+	Password        *string `json:"password"`
+	CurrentPassword *string `json:"current_password"`
+	Version         int     `json:"version"`
+}
+
+// UpdateUserStatusRequest changes a user's status. Version must match the
+// stored version, otherwise the update is rejected.
+type UpdateUserStatusRequest struct {
+	ID      string     `json:"-"`
+	Status  UserStatus `json:"status"`
+	Version int        `json:"version"`
 }
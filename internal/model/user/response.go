@@ -0,0 +1,42 @@
+package userModel
+
+import "time"
+
+// UserResponse is the public representation of a User. Handlers must return
+// this instead of the domain entity so credentials never reach the wire.
+type UserResponse struct {
+	ID        string     `json:"id"`
+	FirstName string     `json:"first_name"`
+	LastName  string     `json:"last_name"`
+	Email     string     `json:"email"`
+	Status    UserStatus `json:"status"`
+	Role      UserRole   `json:"role"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	Version   int        `json:"version"`
+}
+
+func NewUserResponse(user *User) *UserResponse {
+	if user == nil {
+		return nil
+	}
+	return &UserResponse{
+		ID:        user.ID,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Email:     user.Email,
+		Status:    user.Status,
+		Role:      user.Role,
+		CreatedAt: user.CreatedAt,
+		UpdatedAt: user.UpdatedAt,
+		Version:   user.Version,
+	}
+}
+
+func NewUserResponses(users []*User) []*UserResponse {
+	responses := make([]*UserResponse, 0, len(users))
+	for _, user := range users {
+		responses = append(responses, NewUserResponse(user))
+	}
+	return responses
+}
@@ -0,0 +1,18 @@
+package userModel
+
+// UserRole grants access beyond the user's own account
+type UserRole string
+
+const (
+	UserRoleUser  UserRole = "user"
+	UserRoleAdmin UserRole = "admin"
+)
+
+// IsValid reports whether the role is one of the known roles
+func (r UserRole) IsValid() bool {
+	switch r {
+	case UserRoleUser, UserRoleAdmin:
+		return true
+	}
+	return false
+}
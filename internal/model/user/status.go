@@ -3,6 +3,16 @@ package userModel
 type UserStatus string
 
 const (
-	UserStatusActive   UserStatus = "active"
-	UserStatusInactive UserStatus = "inactive"
+	UserStatusActive    UserStatus = "active"
+	UserStatusInactive  UserStatus = "inactive"
+	UserStatusSuspended UserStatus = "suspended"
 )
+
+// IsValid reports whether the status is one of the known statuses
+func (s UserStatus) IsValid() bool {
+	switch s {
+	case UserStatusActive, UserStatusInactive, UserStatusSuspended:
+		return true
+	}
+	return false
+}
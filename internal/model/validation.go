@@ -8,6 +8,7 @@ import (
 // ValidationError represents a validation error
 type ValidationError struct {
 	Field   string `json:"field"`
+	Code    string `json:"code"`
 	Message string `json:"message"`
 }
 
@@ -24,3 +25,38 @@ func (v ValidationErrors) Error() string {
 	}
 	return strings.Join(messages, "; ")
 }
+
+// Add appends a field failure
+func (v *ValidationErrors) Add(field, code, message string) {
+	v.Errors = append(v.Errors, ValidationError{
+		Field:   field,
+		Code:    code,
+		Message: message,
+	})
+}
+
+// Merge appends every failure held by err when it is a ValidationErrors.
+// Any other non-nil error is recorded against field with the "invalid" code.
+func (v *ValidationErrors) Merge(field string, err error) {
+	if err == nil {
+		return
+	}
+	if other, ok := err.(ValidationErrors); ok {
+		v.Errors = append(v.Errors, other.Errors...)
+		return
+	}
+	v.Add(field, CodeInvalid, err.Error())
+}
+
+// HasErrors returns true if there are validation errors
+func (v ValidationErrors) HasErrors() bool {
+	return len(v.Errors) > 0
+}
+
+// Err returns v as an error, or nil when there are no failures
+func (v ValidationErrors) Err() error {
+	if !v.HasErrors() {
+		return nil
+	}
+	return v
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidationErrors(t *testing.T) {
+	var errs ValidationErrors
+	if errs.HasErrors() || errs.Err() != nil {
+		t.Fatal("empty ValidationErrors reports failures")
+	}
+
+	errs.Add("email", CodeInvalidEmail, "invalid email format")
+	errs.Merge("name", nil)
+	errs.Merge("profile", ValidationErrors{Errors: []ValidationError{
+		{Field: "first_name", Code: CodeRequired, Message: "first_name cannot be empty"},
+		{Field: "last_name", Code: CodeMaxLength, Message: "last_name cannot exceed 5 characters"},
+	}})
+	errs.Merge("status", errors.New("unknown status"))
+
+	want := []ValidationError{
+		{Field: "email", Code: CodeInvalidEmail, Message: "invalid email format"},
+		{Field: "first_name", Code: CodeRequired, Message: "first_name cannot be empty"},
+		{Field: "last_name", Code: CodeMaxLength, Message: "last_name cannot exceed 5 characters"},
+		{Field: "status", Code: CodeInvalid, Message: "unknown status"},
+	}
+	if len(errs.Errors) != len(want) {
+		t.Fatalf("got %d failures %v, want %d", len(errs.Errors), errs.Errors, len(want))
+	}
+	for i := range want {
+		if errs.Errors[i] != want[i] {
+			t.Errorf("failure %d = %+v, want %+v", i, errs.Errors[i], want[i])
+		}
+	}
+
+	err := errs.Err()
+	if err == nil || !errs.HasErrors() {
+		t.Fatal("ValidationErrors with failures reports none")
+	}
+	wantMessage := "email: invalid email format; first_name: first_name cannot be empty; " +
+		"last_name: last_name cannot exceed 5 characters; status: unknown status"
+	if err.Error() != wantMessage {
+		t.Errorf("Error() = %q, want %q", err.Error(), wantMessage)
+	}
+}
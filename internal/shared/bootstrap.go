@@ -0,0 +1,237 @@
+package sharedModule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/fbriansyah/go-modular/config"
+	"github.com/fbriansyah/go-modular/pkg/database"
+	"github.com/fbriansyah/go-modular/pkg/tracing"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Dependencies are the shared resources handed to every module factory
+type Dependencies struct {
+	Config    *config.Config
+	Secret    *config.Secret
+	DBManager *database.Manager
+	DB        *database.DB
+	HTTPApp   *fiber.App
+
+	// Services is where modules publish and resolve port implementations
+	Services *ServiceRegistry
+
+	// Events carries domain events between modules
+	Events *EventBus
+
+	// Metrics collects HTTP, pool and query metrics and serves /metrics
+	Metrics *Metrics
+
+	// Outbox publishes events durably within the caller's transaction and
+	// relays them to Events after commit
+	Outbox *Outbox
+}
+
+// BootstrapOptions configures where the bootstrap loads its resources from
+type BootstrapOptions struct {
+	ConfigPath     string
+	SecretPath     string
+	MigrationsPath string
+	RunMigrations  bool
+
+	// TraceExporter, when set, receives spans instead of the exporter
+	// selected by tracing.exporter
+	TraceExporter tracing.Exporter
+}
+
+// Bootstrap wires configuration, the database and the HTTP server, then
+// drives every registered module through its lifecycle
+type Bootstrap struct {
+	opts     BootstrapOptions
+	registry *ModuleRegistry
+	deps     *Dependencies
+}
+
+// NewBootstrap creates a bootstrap with the given options
+func NewBootstrap(opts BootstrapOptions) *Bootstrap {
+	return &Bootstrap{
+		opts:     opts,
+		registry: NewModuleRegistry(),
+	}
+}
+
+// Register adds modules. They are initialized and started in dependency order.
+func (b *Bootstrap) Register(modules ...Module) error {
+	return b.registry.Register(modules...)
+}
+
+// Registry returns the module registry
+func (b *Bootstrap) Registry() *ModuleRegistry {
+	return b.registry
+}
+
+// Initialize loads the configuration, connects and optionally migrates the
+// database, creates the HTTP app and initializes every registered module.
+// When it fails after connecting, call Shutdown to release what it acquired.
+func (b *Bootstrap) Initialize(ctx context.Context) error {
+	conf, secret, err := config.LoadConfig(b.opts.ConfigPath, b.opts.SecretPath)
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	logger := NewLogger(conf.Log)
+	slog.SetDefault(logger)
+
+	exporter := b.opts.TraceExporter
+	if exporter == nil {
+		if exporter, err = NewTraceExporter(conf.Tracing); err != nil {
+			return err
+		}
+	}
+	tracing.SetDefault(tracing.NewTracer(exporter))
+
+	appMetrics := NewMetrics()
+	dbManager, err := database.NewManager(&conf.Database, &secret.Database, b.opts.MigrationsPath,
+		database.WithLogger(logger),
+		database.WithQueryHooks(appMetrics),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := dbManager.Initialize(ctx, b.opts.RunMigrations); err != nil {
+		dbManager.Close()
+		return err
+	}
+
+	b.deps = &Dependencies{
+		Config:    conf,
+		Secret:    secret,
+		DBManager: dbManager,
+		DB:        dbManager.DB,
+		HTTPApp: fiber.New(fiber.Config{
+			AppName:      conf.App.Name,
+			ReadTimeout:  conf.Server.ReadTimeout,
+			WriteTimeout: conf.Server.WriteTimeout,
+			IdleTimeout:  conf.Server.IdleTimeout,
+			BodyLimit:    conf.Server.GetBodyLimit(),
+			ErrorHandler: NewErrorHandler(conf),
+		}),
+		Services: NewServiceRegistry(),
+		Events:   NewEventBus(),
+	}
+	b.deps.Outbox = NewOutbox(conf.Outbox, b.deps.DB, b.deps.Events)
+
+	// metrics, tracing and request IDs wrap every route, so the middleware goes in before any route is mounted
+	b.deps.Metrics = appMetrics
+	b.deps.Metrics.RegisterDBStats(b.deps.DB)
+	b.deps.DB.SetQueryObserver(b.deps.Metrics)
+	b.deps.HTTPApp.Use(b.deps.Metrics.Middleware())
+	b.deps.HTTPApp.Use(TracingMiddleware())
+	b.deps.HTTPApp.Use(RequestIDMiddleware())
+	b.deps.Metrics.SetupRoutes(b.deps.HTTPApp)
+
+	NewHealthHandler(dbManager, b.registry).SetupRoutes(b.deps.HTTPApp)
+
+	if err := b.registry.Init(b.deps); err != nil {
+		return err
+	}
+
+	if err := b.deps.Services.ResolveDeferred(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Dependencies returns the shared dependencies, available after Initialize
+func (b *Bootstrap) Dependencies() *Dependencies {
+	return b.deps
+}
+
+// Run serves HTTP on addr (server.address when empty) until ctx is cancelled
+// or the server fails, then shuts down within server.shutdown_timeout
+func (b *Bootstrap) Run(ctx context.Context, addr string) error {
+	if b.deps == nil {
+		return errors.New("bootstrap is not initialized")
+	}
+
+	// modules outlive the signal context: they are stopped explicitly after the HTTP drain
+	if err := b.registry.Start(context.WithoutCancel(ctx)); err != nil {
+		return errors.Join(err, b.deps.DBManager.Close())
+	}
+	b.deps.Outbox.Start(context.WithoutCancel(ctx))
+
+	if addr == "" {
+		addr = b.deps.Config.Server.GetAddress()
+	}
+
+	listenErr := make(chan error, 1)
+	go func() {
+		slog.Info("Bootstrap", "message", "starting HTTP server", "addr", addr)
+		listenErr <- b.deps.HTTPApp.Listen(addr)
+	}()
+
+	var runErr error
+	select {
+	case <-ctx.Done():
+		slog.Info("Bootstrap", "message", "shutdown signal received")
+	case err := <-listenErr:
+		if err != nil {
+			runErr = fmt.Errorf("http server stopped: %w", err)
+		}
+	}
+
+	timeout := b.deps.Config.Server.GetShutdownTimeout()
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return errors.Join(runErr, b.Shutdown(shutdownCtx))
+}
+
+// Shutdown releases resources in dependency order: it stops accepting
+// connections and drains in-flight requests, stops the outbox relay so no
+// more events reach module subscribers, stops modules (and their background
+// jobs) in reverse startup order, waits for asynchronous event handlers, then
+// closes the database.
+// Every step runs even if an earlier one fails.
+func (b *Bootstrap) Shutdown(ctx context.Context) error {
+	var errs []error
+
+	if b.deps != nil && b.deps.HTTPApp != nil {
+		slog.Info("Bootstrap", "message", "draining HTTP server")
+		if err := b.deps.HTTPApp.ShutdownWithContext(ctx); err != nil {
+			slog.Error("Bootstrap", "message", "failed to shut down HTTP server", "error", err)
+			errs = append(errs, err)
+		}
+	}
+
+	if b.deps != nil && b.deps.Outbox != nil {
+		if err := b.deps.Outbox.Stop(ctx); err != nil {
+			slog.Error("Bootstrap", "message", "failed to stop outbox relay", "error", err)
+			errs = append(errs, err)
+		}
+	}
+
+	if err := b.registry.Stop(ctx); err != nil {
+		errs = append(errs, err)
+	}
+
+	if b.deps != nil && b.deps.Events != nil {
+		if err := b.deps.Events.Close(ctx); err != nil {
+			slog.Error("Bootstrap", "message", "failed to drain event handlers", "error", err)
+			errs = append(errs, err)
+		}
+	}
+
+	if b.deps != nil && b.deps.DBManager != nil {
+		if err := b.deps.DBManager.Close(); err != nil {
+			slog.Error("Bootstrap", "message", "failed to close database", "error", err)
+			errs = append(errs, err)
+		}
+	}
+
+	slog.Info("Bootstrap", "message", "shutdown complete")
+	return errors.Join(errs...)
+}
@@ -0,0 +1,58 @@
+package sharedModule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fbriansyah/go-modular/config"
+)
+
+// stubModule is a module with configurable health that runs onStop when stopped
+type stubModule struct {
+	name   string
+	health HealthStatus
+	onStop func()
+}
+
+func (m *stubModule) Name() string                        { return m.name }
+func (m *stubModule) Init(*Dependencies) error            { return nil }
+func (m *stubModule) Start(context.Context) error         { return nil }
+func (m *stubModule) Health(context.Context) HealthStatus { return m.health }
+
+func (m *stubModule) Stop(context.Context) error {
+	if m.onStop != nil {
+		m.onStop()
+	}
+	return nil
+}
+
+func TestShutdownStopsOutboxBeforeModules(t *testing.T) {
+	ctx := context.Background()
+
+	// the relay never ticks, so it runs without a database
+	outbox := NewOutbox(config.OutboxConfig{PollInterval: time.Hour}, nil, NewEventBus())
+
+	relayRunning := true
+	module := &stubModule{name: "subscriber", onStop: func() {
+		relayRunning = outbox.job.Running()
+	}}
+
+	b := NewBootstrap(BootstrapOptions{})
+	if err := b.Register(module); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	b.deps = &Dependencies{Events: NewEventBus(), Outbox: outbox}
+
+	if err := b.registry.Start(ctx); err != nil {
+		t.Fatalf("Start: %v", err)
+	}
+	outbox.Start(ctx)
+
+	if err := b.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+	if relayRunning {
+		t.Fatal("module was stopped while the outbox relay could still deliver to it")
+	}
+}
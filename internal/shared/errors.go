@@ -0,0 +1,113 @@
+package sharedModule
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/fbriansyah/go-modular/config"
+	"github.com/fbriansyah/go-modular/internal/model"
+	"github.com/fbriansyah/go-modular/pkg/database"
+	"github.com/fbriansyah/go-modular/pkg/logging"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ProblemContentType is the media type of RFC 7807 problem responses
+const ProblemContentType = "application/problem+json"
+
+// ProblemDetails is an RFC 7807 problem document
+type ProblemDetails struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	Errors   any    `json:"errors,omitempty"`
+}
+
+// NewErrorHandler returns the fiber.ErrorHandler shared by every module.
+// Handlers simply return errors; this maps them to a status code and an RFC
+// 7807 body. In production, details of unexpected (5xx) errors are replaced
+// with a generic message and only logged.
+func NewErrorHandler(conf *config.Config) fiber.ErrorHandler {
+	production := conf != nil && conf.App.IsProduction()
+
+	return func(c *fiber.Ctx, err error) error {
+		problem := ProblemFromError(err)
+		problem.Instance = c.Path()
+
+		if problem.Status >= fiber.StatusInternalServerError {
+			logging.FromContext(c.UserContext()).Error("ErrorHandler", "method", c.Method(), "path", c.Path(), "status", problem.Status, "error", err)
+			if production {
+				problem.Detail = http.StatusText(problem.Status)
+			}
+		}
+
+		return c.Status(problem.Status).JSON(problem, ProblemContentType)
+	}
+}
+
+// ProblemFromError maps an error to a problem document
+func ProblemFromError(err error) *ProblemDetails {
+	var fiberErr *fiber.Error
+	var modelValidationErrs model.ValidationErrors
+	var dbValidationErrs database.ValidationErrors
+	var dbValidationErr *database.ValidationError
+
+	switch {
+	case errors.As(err, &fiberErr):
+		return newProblem(fiberErr.Code, fiberErr.Message)
+	case errors.As(err, &modelValidationErrs):
+		problem := newProblem(fiber.StatusUnprocessableEntity, "one or more fields are invalid")
+		problem.Errors = modelValidationErrs.Errors
+		return problem
+	case errors.As(err, &dbValidationErrs):
+		problem := newProblem(fiber.StatusUnprocessableEntity, "one or more fields are invalid")
+		problem.Errors = dbValidationErrs
+		return problem
+	case errors.As(err, &dbValidationErr):
+		problem := newProblem(fiber.StatusUnprocessableEntity, "one or more fields are invalid")
+		problem.Errors = []database.ValidationError{*dbValidationErr}
+		return problem
+	case database.IsNotFoundError(err):
+		return newProblem(fiber.StatusNotFound, publicDetail(err, database.ErrNotFound))
+	case database.IsOptimisticLockError(err):
+		return newProblem(fiber.StatusConflict, publicDetail(err, database.ErrOptimisticLock))
+	case database.IsDuplicateKeyError(err):
+		return newProblem(fiber.StatusConflict, publicDetail(err, database.ErrDuplicateKey))
+	case database.IsForeignKeyViolationError(err):
+		return newProblem(fiber.StatusConflict, publicDetail(err, database.ErrForeignKeyViolation))
+	case database.IsInvalidInputError(err):
+		return newProblem(fiber.StatusBadRequest, publicDetail(err, database.ErrInvalidInput))
+	case errors.Is(err, model.ErrUnauthorized):
+		return newProblem(fiber.StatusUnauthorized, err.Error())
+	}
+
+	return newProblem(fiber.StatusInternalServerError, err.Error())
+}
+
+func newProblem(status int, detail string) *ProblemDetails {
+	return &ProblemDetails{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+	}
+}
+
+// publicDetail returns a client-safe message for a known error. A
+// DatabaseError exposes only its human-readable Message, never the SQL
+// operation, table or driver error. For other errors the first line is used,
+// which for errors.Join(reason, sentinel) is the reason.
+func publicDetail(err error, sentinel error) string {
+	var dbErr *database.DatabaseError
+	if errors.As(err, &dbErr) {
+		if dbErr.Message != "" {
+			return dbErr.Message
+		}
+		return sentinel.Error()
+	}
+
+	detail, _, _ := strings.Cut(err.Error(), "\n")
+	return detail
+}
@@ -0,0 +1,45 @@
+package sharedModule
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/fbriansyah/go-modular/internal/model"
+	authModel "github.com/fbriansyah/go-modular/internal/model/auth"
+	"github.com/fbriansyah/go-modular/pkg/database"
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestProblemFromErrorUnauthorized(t *testing.T) {
+	tests := []struct {
+		err        error
+		wantDetail string
+	}{
+		{authModel.ErrInvalidCredentials, "invalid email or password"},
+		{authModel.ErrUnauthenticated, "authentication required"},
+		{authModel.ErrSessionExpired, "session expired"},
+		{authModel.ErrUserInactive, "user is not active"},
+		{fmt.Errorf("wrapped: %w", model.NewUnauthorizedError("token revoked")), "wrapped: token revoked"},
+	}
+	for _, tt := range tests {
+		if !errors.Is(tt.err, model.ErrUnauthorized) {
+			t.Errorf("errors.Is(%v, model.ErrUnauthorized) = false, want true", tt.err)
+		}
+
+		problem := ProblemFromError(tt.err)
+		if problem.Status != fiber.StatusUnauthorized || problem.Detail != tt.wantDetail {
+			t.Errorf("ProblemFromError(%v) = %d %q, want 401 %q", tt.err, problem.Status, problem.Detail, tt.wantDetail)
+		}
+	}
+
+	if errors.Is(authModel.ErrInvalidCredentials, authModel.ErrUnauthenticated) {
+		t.Error("auth errors must stay distinguishable from each other")
+	}
+}
+
+func TestProblemFromErrorInvalidCursor(t *testing.T) {
+	if problem := ProblemFromError(database.ErrInvalidCursor); problem.Status != fiber.StatusBadRequest {
+		t.Fatalf("ProblemFromError(ErrInvalidCursor) = %d, want 400", problem.Status)
+	}
+}
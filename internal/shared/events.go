@@ -0,0 +1,133 @@
+package sharedModule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/fbriansyah/go-modular/pkg/logging"
+)
+
+// Event is a domain event published between modules. Event types are part of
+// the publishing module's contract and live in its ports package.
+type Event interface {
+	// EventName identifies the event, e.g. "user.created"
+	EventName() string
+}
+
+// EventPublisher is the publishing side of the event bus
+type EventPublisher interface {
+	Publish(ctx context.Context, event Event) error
+}
+
+// subscriber is a type-erased event handler
+type subscriber struct {
+	name   string
+	async  bool
+	handle func(ctx context.Context, event Event) error
+}
+
+// EventBus is an in-process, typed publish/subscribe bus.
+//
+// Synchronous subscribers run in subscription order inside Publish;
+// asynchronous subscribers run in their own goroutine. A failing or
+// panicking subscriber never prevents the others from receiving the event.
+type EventBus struct {
+	mu          sync.RWMutex
+	subscribers map[reflect.Type][]subscriber
+	inflight    sync.WaitGroup
+}
+
+// NewEventBus creates an event bus without subscribers
+func NewEventBus() *EventBus {
+	return &EventBus{
+		subscribers: make(map[reflect.Type][]subscriber),
+	}
+}
+
+// Subscribe registers a synchronous handler for events of type E. name
+// identifies the subscriber in logs and errors.
+func Subscribe[E Event](bus *EventBus, name string, handler func(ctx context.Context, event E) error) {
+	subscribe(bus, name, false, handler)
+}
+
+// SubscribeAsync registers a handler for events of type E that runs in the
+// background. Its errors are logged, never returned to the publisher.
+func SubscribeAsync[E Event](bus *EventBus, name string, handler func(ctx context.Context, event E) error) {
+	subscribe(bus, name, true, handler)
+}
+
+func subscribe[E Event](bus *EventBus, name string, async bool, handler func(ctx context.Context, event E) error) {
+	key := reflect.TypeOf((*E)(nil)).Elem()
+
+	bus.mu.Lock()
+	defer bus.mu.Unlock()
+
+	bus.subscribers[key] = append(bus.subscribers[key], subscriber{
+		name:  name,
+		async: async,
+		handle: func(ctx context.Context, event Event) error {
+			return handler(ctx, event.(E))
+		},
+	})
+}
+
+// Publish delivers event to every subscriber of its type. It returns the
+// joined errors of the synchronous subscribers that failed.
+func (b *EventBus) Publish(ctx context.Context, event Event) error {
+	b.mu.RLock()
+	subscribers := b.subscribers[reflect.TypeOf(event)]
+	b.mu.RUnlock()
+
+	var errs []error
+	for _, sub := range subscribers {
+		if sub.async {
+			b.inflight.Add(1)
+			go func(sub subscriber) {
+				defer b.inflight.Done()
+				// the publisher's request may finish before the handler does
+				if err := deliver(context.WithoutCancel(ctx), sub, event); err != nil {
+					logging.FromContext(ctx).Error("EventBus", "event", event.EventName(), "subscriber", sub.name, "error", err)
+				}
+			}(sub)
+			continue
+		}
+
+		if err := deliver(ctx, sub, event); err != nil {
+			logging.FromContext(ctx).Error("EventBus", "event", event.EventName(), "subscriber", sub.name, "error", err)
+			errs = append(errs, fmt.Errorf("subscriber %q failed to handle %s: %w", sub.name, event.EventName(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Close waits for in-flight asynchronous handlers to finish or ctx to expire
+func (b *EventBus) Close(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		b.inflight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// deliver calls a subscriber, converting a panic into an error
+func deliver(ctx context.Context, sub subscriber, event Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return sub.handle(ctx, event)
+}
+
+var _ EventPublisher = (*EventBus)(nil)
@@ -0,0 +1,117 @@
+package sharedModule
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type orderPlaced struct{ OrderID string }
+
+func (orderPlaced) EventName() string { return "order.placed" }
+
+type orderCancelled struct{ OrderID string }
+
+func (orderCancelled) EventName() string { return "order.cancelled" }
+
+func TestPublishDispatchesByType(t *testing.T) {
+	bus := NewEventBus()
+
+	var placed []string
+	var cancelled int
+	Subscribe(bus, "placed", func(_ context.Context, e orderPlaced) error {
+		placed = append(placed, e.OrderID)
+		return nil
+	})
+	Subscribe(bus, "cancelled", func(context.Context, orderCancelled) error {
+		cancelled++
+		return nil
+	})
+
+	if err := bus.Publish(context.Background(), orderPlaced{OrderID: "o1"}); err != nil {
+		t.Fatalf("Publish: %v", err)
+	}
+
+	if len(placed) != 1 || placed[0] != "o1" {
+		t.Errorf("placed subscriber got %v, want [o1]", placed)
+	}
+	if cancelled != 0 {
+		t.Errorf("cancelled subscriber ran %d times for another event type", cancelled)
+	}
+}
+
+func TestPublishIsolatesFailingSubscribers(t *testing.T) {
+	bus := NewEventBus()
+
+	var delivered int
+	Subscribe(bus, "failing", func(context.Context, orderPlaced) error {
+		return errors.New("boom")
+	})
+	Subscribe(bus, "panicking", func(context.Context, orderPlaced) error {
+		panic("kaboom")
+	})
+	Subscribe(bus, "healthy", func(context.Context, orderPlaced) error {
+		delivered++
+		return nil
+	})
+
+	err := bus.Publish(context.Background(), orderPlaced{OrderID: "o1"})
+	if err == nil {
+		t.Fatal("Publish: got nil, want the failing subscribers' errors")
+	}
+	for _, name := range []string{`"failing"`, `"panicking"`} {
+		if !strings.Contains(err.Error(), name) {
+			t.Errorf("error %q does not name subscriber %s", err, name)
+		}
+	}
+	if strings.Contains(err.Error(), `"healthy"`) {
+		t.Errorf("error %q names the healthy subscriber", err)
+	}
+	if delivered != 1 {
+		t.Errorf("healthy subscriber ran %d times, want 1", delivered)
+	}
+}
+
+func TestSubscribeAsyncRunsInBackground(t *testing.T) {
+	bus := NewEventBus()
+
+	release := make(chan struct{})
+	var delivered atomic.Int32
+	SubscribeAsync(bus, "slow", func(ctx context.Context, _ orderPlaced) error {
+		<-release
+		// the handler outlives the publisher's context
+		if ctx.Err() == nil {
+			delivered.Add(1)
+		}
+		return nil
+	})
+	SubscribeAsync(bus, "failing", func(context.Context, orderPlaced) error {
+		return errors.New("boom")
+	})
+	SubscribeAsync(bus, "panicking", func(context.Context, orderPlaced) error {
+		panic("kaboom")
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := bus.Publish(ctx, orderPlaced{OrderID: "o1"}); err != nil {
+		t.Fatalf("Publish: got %v, want async errors kept from the publisher", err)
+	}
+	cancel()
+
+	closeCtx, closeCancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer closeCancel()
+	if err := bus.Close(closeCtx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Close with a blocked handler: got %v, want DeadlineExceeded", err)
+	}
+
+	close(release)
+	if err := bus.Close(context.Background()); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if delivered.Load() != 1 {
+		t.Fatalf("async subscriber delivered %d times, want 1", delivered.Load())
+	}
+}
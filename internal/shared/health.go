@@ -0,0 +1,180 @@
+package sharedModule
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/fbriansyah/go-modular/pkg/database"
+	"github.com/gofiber/fiber/v2"
+)
+
+// healthCheckTimeout bounds every readiness and health check
+const healthCheckTimeout = 5 * time.Second
+
+// ComponentHealth is the health of one component in the detailed health report
+type ComponentHealth struct {
+	Status    string         `json:"status"`
+	Message   string         `json:"message,omitempty"`
+	LatencyMS float64        `json:"latency_ms"`
+	Details   map[string]any `json:"details,omitempty"`
+}
+
+// HealthReport is the body of the detailed health endpoint
+type HealthReport struct {
+	Status     string                     `json:"status"`
+	Timestamp  time.Time                  `json:"timestamp"`
+	Components map[string]ComponentHealth `json:"components"`
+}
+
+// HealthHandler serves the liveness, readiness and detailed health endpoints
+type HealthHandler struct {
+	dbManager *database.Manager
+	registry  *ModuleRegistry
+}
+
+// NewHealthHandler creates a health handler checking the database and every registered module
+func NewHealthHandler(dbManager *database.Manager, registry *ModuleRegistry) *HealthHandler {
+	return &HealthHandler{dbManager: dbManager, registry: registry}
+}
+
+// SetupRoutes mounts /healthz, /readyz and /health
+func (h *HealthHandler) SetupRoutes(httpApp *fiber.App) {
+	RegisterRoutes(httpApp, nil, []Route{
+		{Method: fiber.MethodGet, Path: "/healthz", Access: AccessAnonymous, Handler: h.Liveness},
+		{Method: fiber.MethodGet, Path: "/readyz", Access: AccessAnonymous, Handler: h.Readiness},
+		{Method: fiber.MethodGet, Path: "/health", Access: AccessAnonymous, Handler: h.Health},
+	})
+}
+
+// Liveness reports that the process is up and serving requests
+func (h *HealthHandler) Liveness(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{"status": "alive"})
+}
+
+// Readiness reports whether the instance can take traffic: the database is
+// reachable, migrations are not dirty and every module is healthy. It
+// responds 503 with the failing checks otherwise.
+func (h *HealthHandler) Readiness(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), healthCheckTimeout)
+	defer cancel()
+
+	var problems []string
+	for name, component := range h.check(ctx, false) {
+		if component.Status != StatusHealthy {
+			problems = append(problems, fmt.Sprintf("%s: %s", name, component.Message))
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"status": "not ready", "problems": problems})
+	}
+	return c.JSON(fiber.Map{"status": "ready"})
+}
+
+// Health reports the status and check latency of every component. It
+// responds 503 when any component is unhealthy.
+func (h *HealthHandler) Health(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), healthCheckTimeout)
+	defer cancel()
+
+	report := HealthReport{
+		Status:     StatusHealthy,
+		Timestamp:  time.Now(),
+		Components: h.check(ctx, true),
+	}
+	for _, component := range report.Components {
+		if component.Status != StatusHealthy {
+			report.Status = StatusUnhealthy
+		}
+	}
+
+	if report.Status != StatusHealthy {
+		c.Status(fiber.StatusServiceUnavailable)
+	}
+	return c.JSON(report)
+}
+
+// check runs every component check. detailed adds pool statistics and module details.
+func (h *HealthHandler) check(ctx context.Context, detailed bool) map[string]ComponentHealth {
+	components := map[string]ComponentHealth{
+		"database":   h.checkDatabase(ctx, detailed),
+		"migrations": h.checkMigrations(),
+	}
+
+	modules, err := h.registry.Modules()
+	if err != nil {
+		components["modules"] = ComponentHealth{Status: StatusUnhealthy, Message: err.Error()}
+	}
+
+	for _, module := range modules {
+		start := time.Now()
+		status := module.Health(ctx)
+
+		component := ComponentHealth{
+			Status:    status.Status,
+			Message:   status.Message,
+			LatencyMS: millis(time.Since(start)),
+		}
+		if detailed {
+			component.Details = status.Details
+		}
+		components["module:"+module.Name()] = component
+	}
+
+	return components
+}
+
+func (h *HealthHandler) checkDatabase(ctx context.Context, detailed bool) ComponentHealth {
+	if h.dbManager == nil {
+		return ComponentHealth{Status: StatusUnhealthy, Message: "database is not initialized"}
+	}
+
+	if !detailed {
+		start := time.Now()
+		if err := h.dbManager.HealthChecker.QuickCheck(ctx); err != nil {
+			return ComponentHealth{Status: StatusUnhealthy, Message: err.Error(), LatencyMS: millis(time.Since(start))}
+		}
+		return ComponentHealth{Status: StatusHealthy, LatencyMS: millis(time.Since(start))}
+	}
+
+	status := h.dbManager.GetHealthStatus(ctx)
+	return ComponentHealth{
+		Status:    status.Status,
+		Message:   status.Message,
+		LatencyMS: millis(status.Latency),
+		Details:   map[string]any{"connections": status.Connections},
+	}
+}
+
+func (h *HealthHandler) checkMigrations() ComponentHealth {
+	if h.dbManager == nil || h.dbManager.MigrationRunner == nil {
+		return ComponentHealth{Status: StatusUnhealthy, Message: "migration runner is not initialized"}
+	}
+
+	start := time.Now()
+	version, dirty, err := h.dbManager.MigrationRunner.Version()
+	latency := millis(time.Since(start))
+
+	if err != nil {
+		return ComponentHealth{Status: StatusUnhealthy, Message: err.Error(), LatencyMS: latency}
+	}
+
+	details := map[string]any{"version": version, "dirty": dirty}
+	if dirty {
+		return ComponentHealth{
+			Status:    StatusUnhealthy,
+			Message:   fmt.Sprintf("migration %d is dirty", version),
+			LatencyMS: latency,
+			Details:   details,
+		}
+	}
+	return ComponentHealth{Status: StatusHealthy, LatencyMS: latency, Details: details}
+}
+
+// millis converts d to fractional milliseconds
+func millis(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
@@ -0,0 +1,101 @@
+package sharedModule
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// newHealthApp serves the health endpoints for modules, without a database
+func newHealthApp(t *testing.T, modules ...Module) *fiber.App {
+	t.Helper()
+
+	registry := NewModuleRegistry()
+	if err := registry.Register(modules...); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	app := fiber.New()
+	NewHealthHandler(nil, registry).SetupRoutes(app)
+	return app
+}
+
+func getJSON(t *testing.T, app *fiber.App, path string, body any) int {
+	t.Helper()
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, path, nil))
+	if err != nil {
+		t.Fatalf("GET %s: %v", path, err)
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("GET %s: read body: %v", path, err)
+	}
+	if err := json.Unmarshal(raw, body); err != nil {
+		t.Fatalf("GET %s: invalid JSON %s: %v", path, raw, err)
+	}
+	return resp.StatusCode
+}
+
+func TestReadinessReportsUnhealthyModule(t *testing.T) {
+	app := newHealthApp(t,
+		&stubModule{name: "users", health: Healthy("")},
+		&stubModule{name: "payments", health: Unhealthy("provider unreachable")},
+	)
+
+	var liveness struct {
+		Status string `json:"status"`
+	}
+	if status := getJSON(t, app, "/healthz", &liveness); status != fiber.StatusOK || liveness.Status != "alive" {
+		t.Fatalf("/healthz = %d %+v, want 200 alive while a module is unhealthy", status, liveness)
+	}
+
+	var readiness struct {
+		Status   string   `json:"status"`
+		Problems []string `json:"problems"`
+	}
+	if status := getJSON(t, app, "/readyz", &readiness); status != fiber.StatusServiceUnavailable {
+		t.Fatalf("/readyz = %d, want 503", status)
+	}
+	if readiness.Status != "not ready" || !slices.Contains(readiness.Problems, "module:payments: provider unreachable") {
+		t.Fatalf("/readyz = %+v, want the failing module among the problems", readiness)
+	}
+	for _, problem := range readiness.Problems {
+		if problem == "module:users: " {
+			t.Errorf("/readyz lists the healthy module: %v", readiness.Problems)
+		}
+	}
+
+	var report HealthReport
+	if status := getJSON(t, app, "/health", &report); status != fiber.StatusServiceUnavailable || report.Status != StatusUnhealthy {
+		t.Fatalf("/health = %d %s, want 503 unhealthy", status, report.Status)
+	}
+	if got := report.Components["module:payments"]; got.Status != StatusUnhealthy || got.Message != "provider unreachable" {
+		t.Errorf("payments component = %+v, want unhealthy with its message", got)
+	}
+	if got := report.Components["module:users"]; got.Status != StatusHealthy {
+		t.Errorf("users component = %+v, want healthy", got)
+	}
+}
+
+func TestReadinessFailsWithoutDatabase(t *testing.T) {
+	app := newHealthApp(t, &stubModule{name: "users", health: Healthy("")})
+
+	var readiness struct {
+		Problems []string `json:"problems"`
+	}
+	if status := getJSON(t, app, "/readyz", &readiness); status != fiber.StatusServiceUnavailable {
+		t.Fatalf("/readyz = %d, want 503", status)
+	}
+	want := []string{"database: database is not initialized", "migrations: migration runner is not initialized"}
+	if !slices.Equal(readiness.Problems, want) {
+		t.Fatalf("/readyz problems = %v, want %v", readiness.Problems, want)
+	}
+}
@@ -0,0 +1,100 @@
+package sharedModule
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// PeriodicJob runs a function on a fixed interval in the background until stopped
+type PeriodicJob struct {
+	name     string
+	interval time.Duration
+	run      func(ctx context.Context) error
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewPeriodicJob creates a job that calls run every interval
+func NewPeriodicJob(name string, interval time.Duration, run func(ctx context.Context) error) *PeriodicJob {
+	return &PeriodicJob{
+		name:     name,
+		interval: interval,
+		run:      run,
+	}
+}
+
+// Start launches the job loop in a goroutine. Calling Start on a running job is a no-op.
+func (j *PeriodicJob) Start(ctx context.Context) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	j.cancel = cancel
+	j.done = make(chan struct{})
+
+	go j.loop(ctx, j.done)
+}
+
+// Stop cancels the job and waits for the current run to finish or ctx to expire
+func (j *PeriodicJob) Stop(ctx context.Context) error {
+	j.mu.Lock()
+	cancel, done := j.cancel, j.done
+	j.cancel, j.done = nil, nil
+	j.mu.Unlock()
+
+	if cancel == nil {
+		return nil
+	}
+	cancel()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Running reports whether the job loop is active
+func (j *PeriodicJob) Running() bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.done == nil {
+		return false
+	}
+	select {
+	case <-j.done:
+		return false
+	default:
+		return true
+	}
+}
+
+func (j *PeriodicJob) loop(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	slog.Info("PeriodicJob", "job", j.name, "message", "started", "interval", j.interval)
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("PeriodicJob", "job", j.name, "message", "stopped")
+			return
+		case <-ticker.C:
+			if err := j.run(ctx); err != nil && ctx.Err() == nil {
+				slog.Error("PeriodicJob", "job", j.name, "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package sharedModule
+
+import (
+	"log/slog"
+	"os"
+
+	"github.com/fbriansyah/go-modular/config"
+)
+
+// NewLogger creates a logger writing to stderr at the configured level and format
+func NewLogger(conf config.LogConfig) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: conf.GetLevel()}
+
+	if conf.IsJSON() {
+		return slog.New(slog.NewJSONHandler(os.Stderr, opts))
+	}
+	return slog.New(slog.NewTextHandler(os.Stderr, opts))
+}
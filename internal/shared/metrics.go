@@ -0,0 +1,167 @@
+package sharedModule
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/fbriansyah/go-modular/pkg/database"
+	"github.com/fbriansyah/go-modular/pkg/metrics"
+	"github.com/gofiber/fiber/v2"
+)
+
+// unmatchedRoute labels requests that matched no route, keeping label cardinality bounded
+const unmatchedRoute = "unmatched"
+
+// Metrics collects HTTP, connection pool, repository query and SQL statement metrics and
+// serves them in the Prometheus text format
+type Metrics struct {
+	registry *metrics.Registry
+
+	httpRequests *metrics.CounterVec
+	httpDuration *metrics.HistogramVec
+	queryTotal   *metrics.CounterVec
+	queryErrors  *metrics.CounterVec
+	queryLatency *metrics.HistogramVec
+
+	statementLatency *metrics.HistogramVec
+	statementErrors  *metrics.CounterVec
+}
+
+// NewMetrics creates the application metrics
+func NewMetrics() *Metrics {
+	registry := metrics.NewRegistry()
+
+	return &Metrics{
+		registry: registry,
+		httpRequests: registry.NewCounterVec(
+			"http_requests_total", "HTTP requests by method, route and status code.",
+			"method", "route", "status",
+		),
+		httpDuration: registry.NewHistogramVec(
+			"http_request_duration_seconds", "HTTP request latency by method and route.",
+			nil, "method", "route",
+		),
+		queryTotal: registry.NewCounterVec(
+			"db_queries_total", "Repository operations by table and operation.",
+			"table", "op",
+		),
+		queryErrors: registry.NewCounterVec(
+			"db_query_errors_total", "Failed repository operations by table, operation and error kind.",
+			"table", "op", "error",
+		),
+		queryLatency: registry.NewHistogramVec(
+			"db_query_duration_seconds", "Repository operation latency by table and operation.",
+			nil, "table", "op",
+		),
+		statementLatency: registry.NewHistogramVec(
+			"db_statement_duration_seconds", "SQL statement latency by operation, e.g. SELECT.",
+			nil, "operation",
+		),
+		statementErrors: registry.NewCounterVec(
+			"db_statement_errors_total", "Failed SQL statements by operation; no-rows results are not failures.",
+			"operation",
+		),
+	}
+}
+
+// Registry returns the underlying registry so modules can add their own metrics
+func (m *Metrics) Registry() *metrics.Registry {
+	return m.registry
+}
+
+// ObserveQuery implements database.QueryObserver
+func (m *Metrics) ObserveQuery(table, op string, duration time.Duration, err error) {
+	m.queryTotal.Inc(table, op)
+	m.queryLatency.Observe(duration.Seconds(), table, op)
+	if err != nil {
+		m.queryErrors.Inc(table, op, database.ErrorKind(err))
+	}
+}
+
+// BeforeQuery implements database.QueryHook
+func (m *Metrics) BeforeQuery(ctx context.Context, _ *database.QueryEvent) context.Context {
+	return ctx
+}
+
+// AfterQuery implements database.QueryHook
+func (m *Metrics) AfterQuery(_ context.Context, event *database.QueryEvent) {
+	operation := event.Operation()
+	m.statementLatency.Observe(event.Duration.Seconds(), operation)
+	if event.Err != nil && !errors.Is(event.Err, sql.ErrNoRows) {
+		m.statementErrors.Inc(operation)
+	}
+}
+
+// RegisterDBStats exposes the connection pool statistics of db
+func (m *Metrics) RegisterDBStats(db *database.DB) {
+	gauges := []struct {
+		name, help string
+		read       func(s sql.DBStats) float64
+	}{
+		{"db_max_open_connections", "Maximum number of open connections to the database.", func(s sql.DBStats) float64 { return float64(s.MaxOpenConnections) }},
+		{"db_open_connections", "Established connections, in use and idle.", func(s sql.DBStats) float64 { return float64(s.OpenConnections) }},
+		{"db_in_use_connections", "Connections currently in use.", func(s sql.DBStats) float64 { return float64(s.InUse) }},
+		{"db_idle_connections", "Idle connections.", func(s sql.DBStats) float64 { return float64(s.Idle) }},
+	}
+	counters := []struct {
+		name, help string
+		read       func(s sql.DBStats) float64
+	}{
+		{"db_wait_count_total", "Connections waited for.", func(s sql.DBStats) float64 { return float64(s.WaitCount) }},
+		{"db_wait_duration_seconds_total", "Time blocked waiting for a new connection.", func(s sql.DBStats) float64 { return s.WaitDuration.Seconds() }},
+		{"db_max_idle_closed_total", "Connections closed due to max_idle_conns.", func(s sql.DBStats) float64 { return float64(s.MaxIdleClosed) }},
+		{"db_max_idle_time_closed_total", "Connections closed due to conn_max_idle_time.", func(s sql.DBStats) float64 { return float64(s.MaxIdleTimeClosed) }},
+		{"db_max_lifetime_closed_total", "Connections closed due to conn_max_lifetime.", func(s sql.DBStats) float64 { return float64(s.MaxLifetimeClosed) }},
+	}
+
+	for _, g := range gauges {
+		read := g.read
+		m.registry.NewGaugeFunc(g.name, g.help, func() float64 { return read(db.Stats()) })
+	}
+	for _, c := range counters {
+		read := c.read
+		m.registry.NewCounterFunc(c.name, c.help, func() float64 { return read(db.Stats()) })
+	}
+}
+
+// Middleware records the count and latency of every request. Errors are
+// handed to the app's error handler first so the recorded status is the
+// one the client receives.
+func (m *Metrics) Middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+		middlewareRoute := c.Route()
+
+		completeRequest(c, c.Next())
+
+		// the route stays on this middleware when no handler matched
+		route := c.Route().Path
+		if c.Route() == middlewareRoute {
+			route = unmatchedRoute
+		}
+		method := c.Method()
+
+		m.httpRequests.Inc(method, route, strconv.Itoa(c.Response().StatusCode()))
+		m.httpDuration.Observe(time.Since(start).Seconds(), method, route)
+
+		return nil
+	}
+}
+
+// SetupRoutes mounts GET /metrics
+func (m *Metrics) SetupRoutes(httpApp *fiber.App) {
+	RegisterRoutes(httpApp, nil, []Route{
+		{Method: fiber.MethodGet, Path: "/metrics", Access: AccessAnonymous, Handler: m.Handler},
+	})
+}
+
+// Handler writes every metric in the Prometheus text format
+func (m *Metrics) Handler(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderContentType, metrics.ContentType)
+	return m.registry.WriteText(c.Response().BodyWriter())
+}
+
+var _ database.QueryObserver = (*Metrics)(nil)
@@ -1,5 +1,62 @@
 package sharedModule
 
-type Application interface {
-	Run()
+import "context"
+
+// Module is the lifecycle contract every module implements.
+//
+// The registry calls Init once with the shared dependencies (in dependency
+// order), then Start before the HTTP server accepts traffic, and Stop in
+// reverse order during shutdown. Health is polled by readiness checks.
+type Module interface {
+	// Name uniquely identifies the module, e.g. "user"
+	Name() string
+
+	// Init wires the module's repositories, services and routes
+	Init(deps *Dependencies) error
+
+	// Start launches background work; it must not block
+	Start(ctx context.Context) error
+
+	// Stop halts background work, waiting at most until ctx expires
+	Stop(ctx context.Context) error
+
+	// Health reports whether the module is able to serve requests
+	Health(ctx context.Context) HealthStatus
+}
+
+// DependentModule is implemented by modules that must be initialized and
+// started after other modules
+type DependentModule interface {
+	Module
+
+	// DependsOn returns the names of the modules this module requires
+	DependsOn() []string
+}
+
+// Health status values, aligned with database.HealthStatus
+const (
+	StatusHealthy   = "healthy"
+	StatusUnhealthy = "unhealthy"
+)
+
+// HealthStatus is the health of a single module
+type HealthStatus struct {
+	Status  string         `json:"status"`
+	Message string         `json:"message,omitempty"`
+	Details map[string]any `json:"details,omitempty"`
+}
+
+// Healthy returns a healthy status with an optional message
+func Healthy(message string) HealthStatus {
+	return HealthStatus{Status: StatusHealthy, Message: message}
+}
+
+// Unhealthy returns an unhealthy status with the reason
+func Unhealthy(message string) HealthStatus {
+	return HealthStatus{Status: StatusUnhealthy, Message: message}
+}
+
+// IsHealthy reports whether the status is healthy
+func (h HealthStatus) IsHealthy() bool {
+	return h.Status == StatusHealthy
 }
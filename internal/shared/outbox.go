@@ -0,0 +1,154 @@
+package sharedModule
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/fbriansyah/go-modular/config"
+	"github.com/fbriansyah/go-modular/pkg/database"
+)
+
+// Outbox publishes events durably: Publish stores them in the outbox table
+// within the caller's transaction, and a background relay later delivers
+// them to the event bus, or to the sink set with WithOutboxSink.
+//
+// Delivery is at-least-once, so subscribers of outbox events must be idempotent.
+type Outbox struct {
+	writer *database.OutboxWriter
+	bus    *EventBus
+	sink   database.OutboxSink
+	relay  *database.OutboxRelay
+	job    *PeriodicJob
+
+	mu       sync.RWMutex
+	decoders map[string]func(payload json.RawMessage) (Event, error)
+}
+
+// OutboxOption configures an Outbox
+type OutboxOption func(*Outbox)
+
+// WithOutboxSink delivers outbox messages to sink instead of the event bus
+func WithOutboxSink(sink database.OutboxSink) OutboxOption {
+	return func(o *Outbox) {
+		o.sink = sink
+	}
+}
+
+// NewOutbox creates an outbox relaying to bus according to conf
+func NewOutbox(conf config.OutboxConfig, db *database.DB, bus *EventBus, opts ...OutboxOption) *Outbox {
+	o := &Outbox{
+		writer:   database.NewOutboxWriter(db),
+		bus:      bus,
+		decoders: make(map[string]func(payload json.RawMessage) (Event, error)),
+	}
+	o.sink = database.OutboxSinkFunc(o.dispatch)
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	relayOpts := database.DefaultOutboxRelayOptions()
+	if conf.BatchSize > 0 {
+		relayOpts.BatchSize = conf.BatchSize
+	}
+	if conf.MaxAttempts != 0 {
+		relayOpts.MaxAttempts = max(conf.MaxAttempts, 0)
+	}
+	if conf.MinBackoff > 0 {
+		relayOpts.MinBackoff = conf.MinBackoff
+	}
+	if conf.MaxBackoff > 0 {
+		relayOpts.MaxBackoff = conf.MaxBackoff
+	}
+	o.relay = database.NewOutboxRelay(db, o.sink, relayOpts)
+
+	if interval := conf.GetPollInterval(); interval > 0 {
+		o.job = NewPeriodicJob("outbox.relay", interval, o.relayAll)
+	}
+
+	return o
+}
+
+// RegisterOutboxEvent lets the outbox carry events of type E. Modules
+// register the events they publish during Init.
+func RegisterOutboxEvent[E Event](o *Outbox) {
+	var zero E
+	name := zero.EventName()
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.decoders[name] = func(payload json.RawMessage) (Event, error) {
+		var event E
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return nil, err
+		}
+		return event, nil
+	}
+}
+
+// Publish stores event in the outbox. Call it with the context of the
+// transaction that persists the change the event describes.
+func (o *Outbox) Publish(ctx context.Context, event Event) error {
+	o.mu.RLock()
+	_, ok := o.decoders[event.EventName()]
+	o.mu.RUnlock()
+
+	if !ok {
+		return fmt.Errorf("%w: %s (%s) is not registered", database.ErrUnknownEvent, event.EventName(), reflect.TypeOf(event))
+	}
+
+	return o.writer.Write(ctx, event.EventName(), event)
+}
+
+// Start launches the relay loop
+func (o *Outbox) Start(ctx context.Context) {
+	if o.job != nil {
+		o.job.Start(ctx)
+	}
+}
+
+// Stop stops the relay loop, waiting for the current batch to finish or ctx to expire
+func (o *Outbox) Stop(ctx context.Context) error {
+	if o.job == nil {
+		return nil
+	}
+	return o.job.Stop(ctx)
+}
+
+// relayAll processes full batches until the backlog of due messages is drained
+func (o *Outbox) relayAll(ctx context.Context) error {
+	batchSize := o.relay.BatchSize()
+	for {
+		delivered, err := o.relay.ProcessBatch(ctx)
+		if err != nil {
+			return err
+		}
+		if delivered < batchSize || ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+// dispatch decodes msg and publishes it on the event bus. Errors from
+// synchronous subscribers fail the delivery so the message is retried.
+func (o *Outbox) dispatch(ctx context.Context, msg *database.OutboxMessage) error {
+	o.mu.RLock()
+	decode, ok := o.decoders[msg.EventName]
+	o.mu.RUnlock()
+
+	if !ok {
+		return fmt.Errorf("%w: %s", database.ErrUnknownEvent, msg.EventName)
+	}
+
+	event, err := decode(msg.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to decode %s: %w", msg.EventName, err)
+	}
+
+	return o.bus.Publish(ctx, event)
+}
+
+var _ EventPublisher = (*Outbox)(nil)
@@ -0,0 +1,33 @@
+package sharedModule
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// PaginationLinks builds relative next/prev links for an offset-paginated
+// listing, preserving the request's other query parameters. An empty string
+// means there is no page in that direction.
+func PaginationLinks(c *fiber.Ctx, limit, offset int, total int64) (next, prev string) {
+	query, err := url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		query = url.Values{}
+	}
+
+	link := func(offset int) string {
+		query.Set("limit", strconv.Itoa(limit))
+		query.Set("offset", strconv.Itoa(offset))
+		return c.Path() + "?" + query.Encode()
+	}
+
+	if int64(offset+limit) < total {
+		next = link(offset + limit)
+	}
+	if offset > 0 {
+		prev = link(max(offset-limit, 0))
+	}
+
+	return next, prev
+}
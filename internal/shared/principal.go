@@ -0,0 +1,39 @@
+package sharedModule
+
+import (
+	"context"
+
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	"github.com/fbriansyah/go-modular/pkg/logging"
+)
+
+// Principal is the authenticated caller attached to a request
+type Principal struct {
+	User      *userModel.User
+	SessionID string
+}
+
+// IsAdmin reports whether the caller holds the admin role
+func (p *Principal) IsAdmin() bool {
+	return p != nil && p.User != nil && p.User.IsAdmin()
+}
+
+// principalKey is the context key for the request principal
+type principalKey struct{}
+
+// PrincipalLocalsKey is the fiber.Ctx Locals key holding the request principal
+const PrincipalLocalsKey = "principal"
+
+// WithPrincipal adds the principal to the context and its user ID to the context logger
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	if principal != nil && principal.User != nil {
+		ctx = logging.With(ctx, "user_id", principal.User.ID)
+	}
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext retrieves the principal from the context
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
@@ -0,0 +1,194 @@
+package sharedModule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+)
+
+// ModuleRegistry holds the application's modules and drives their lifecycle
+// in dependency order
+type ModuleRegistry struct {
+	mu      sync.Mutex
+	modules []Module
+	byName  map[string]Module
+	ordered []Module
+	started []Module
+}
+
+// AggregateHealth is the combined health of every registered module
+type AggregateHealth struct {
+	Status  string                  `json:"status"`
+	Modules map[string]HealthStatus `json:"modules"`
+}
+
+// NewModuleRegistry creates an empty registry
+func NewModuleRegistry() *ModuleRegistry {
+	return &ModuleRegistry{
+		byName: make(map[string]Module),
+	}
+}
+
+// Register adds modules. Module names must be unique.
+func (r *ModuleRegistry) Register(modules ...Module) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, module := range modules {
+		name := module.Name()
+		if _, exists := r.byName[name]; exists {
+			return fmt.Errorf("module %q is already registered", name)
+		}
+		r.byName[name] = module
+		r.modules = append(r.modules, module)
+	}
+	r.ordered = nil
+
+	return nil
+}
+
+// Modules returns the modules in startup order
+func (r *ModuleRegistry) Modules() ([]Module, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.resolveOrder()
+}
+
+// Init initializes every module, dependencies first
+func (r *ModuleRegistry) Init(deps *Dependencies) error {
+	modules, err := r.Modules()
+	if err != nil {
+		return err
+	}
+
+	for _, module := range modules {
+		if err := module.Init(deps); err != nil {
+			return fmt.Errorf("failed to initialize module %q: %w", module.Name(), err)
+		}
+		slog.Info("ModuleRegistry", "message", "module initialized", "module", module.Name())
+	}
+
+	return nil
+}
+
+// Start starts every module, dependencies first. If a module fails to start,
+// the modules already started are stopped in reverse order.
+func (r *ModuleRegistry) Start(ctx context.Context) error {
+	modules, err := r.Modules()
+	if err != nil {
+		return err
+	}
+
+	for _, module := range modules {
+		if err := module.Start(ctx); err != nil {
+			startErr := fmt.Errorf("failed to start module %q: %w", module.Name(), err)
+			return errors.Join(startErr, r.Stop(ctx))
+		}
+
+		r.mu.Lock()
+		r.started = append(r.started, module)
+		r.mu.Unlock()
+		slog.Info("ModuleRegistry", "message", "module started", "module", module.Name())
+	}
+
+	return nil
+}
+
+// Stop stops the started modules in reverse startup order. Every module is
+// asked to stop even if an earlier one fails.
+func (r *ModuleRegistry) Stop(ctx context.Context) error {
+	r.mu.Lock()
+	started := r.started
+	r.started = nil
+	r.mu.Unlock()
+
+	var errs []error
+	for i := len(started) - 1; i >= 0; i-- {
+		module := started[i]
+		if err := module.Stop(ctx); err != nil {
+			slog.Error("ModuleRegistry", "message", "failed to stop module", "module", module.Name(), "error", err)
+			errs = append(errs, fmt.Errorf("failed to stop module %q: %w", module.Name(), err))
+			continue
+		}
+		slog.Info("ModuleRegistry", "message", "module stopped", "module", module.Name())
+	}
+
+	return errors.Join(errs...)
+}
+
+// Health polls every module. The aggregate is unhealthy if any module is.
+func (r *ModuleRegistry) Health(ctx context.Context) AggregateHealth {
+	r.mu.Lock()
+	modules := append([]Module(nil), r.modules...)
+	r.mu.Unlock()
+
+	health := AggregateHealth{
+		Status:  StatusHealthy,
+		Modules: make(map[string]HealthStatus, len(modules)),
+	}
+	for _, module := range modules {
+		status := module.Health(ctx)
+		health.Modules[module.Name()] = status
+		if !status.IsHealthy() {
+			health.Status = StatusUnhealthy
+		}
+	}
+
+	return health
+}
+
+// resolveOrder topologically sorts the modules by DependsOn, keeping
+// registration order between independent modules. Callers must hold r.mu.
+func (r *ModuleRegistry) resolveOrder() ([]Module, error) {
+	if r.ordered != nil {
+		return r.ordered, nil
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(r.modules))
+	ordered := make([]Module, 0, len(r.modules))
+
+	var visit func(module Module, path []string) error
+	visit = func(module Module, path []string) error {
+		name := module.Name()
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("module dependency cycle: %v -> %s", path, name)
+		}
+
+		state[name] = visiting
+		if dependent, ok := module.(DependentModule); ok {
+			for _, depName := range dependent.DependsOn() {
+				dep, exists := r.byName[depName]
+				if !exists {
+					return fmt.Errorf("module %q depends on unregistered module %q", name, depName)
+				}
+				if err := visit(dep, append(path, name)); err != nil {
+					return err
+				}
+			}
+		}
+		state[name] = visited
+		ordered = append(ordered, module)
+
+		return nil
+	}
+
+	for _, module := range r.modules {
+		if err := visit(module, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	r.ordered = ordered
+	return ordered, nil
+}
@@ -0,0 +1,67 @@
+package sharedModule
+
+import (
+	"context"
+
+	"github.com/fbriansyah/go-modular/pkg/logging"
+	"github.com/fbriansyah/go-modular/pkg/tracing"
+	"github.com/fbriansyah/go-modular/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+// RequestIDHeader carries the request ID in both directions
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength bounds client-supplied request IDs
+const maxRequestIDLength = 128
+
+// requestIDKey is the context key for the request ID
+type requestIDKey struct{}
+
+// RequestIDMiddleware assigns every request an ID, reusing a well-formed
+// incoming X-Request-ID, echoes it in the response and binds a logger with
+// the request ID (and trace ID, when tracing is active) to the user context
+func RequestIDMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		id := c.Get(RequestIDHeader)
+		if !validRequestID(id) {
+			id = utils.GenerateUUID()
+		}
+		c.Set(RequestIDHeader, id)
+
+		ctx := context.WithValue(c.UserContext(), requestIDKey{}, id)
+		args := []any{"request_id", id}
+		if sc := tracing.SpanContextFromContext(ctx); sc.IsValid() {
+			args = append(args, "trace_id", sc.TraceID.String())
+		}
+		c.SetUserContext(logging.With(ctx, args...))
+
+		return c.Next()
+	}
+}
+
+// RequestIDFromContext returns the ID of the current request, if any
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// bindRoute adds the matched route template to the request logger
+func bindRoute(c *fiber.Ctx) error {
+	c.SetUserContext(logging.With(c.UserContext(), "route", c.Route().Path))
+	return c.Next()
+}
+
+// validRequestID accepts short IDs made of printable ASCII without spaces,
+// so client input cannot forge log lines
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,105 @@
+package sharedModule
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fbriansyah/go-modular/pkg/logging"
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestValidRequestID(t *testing.T) {
+	tests := []struct {
+		name string
+		id   string
+		want bool
+	}{
+		{"uuid", "4f0c7a52-1c1e-4b7a-9d55-2f1a3c9e8b10", true},
+		{"printable ascii", "req_42:abc/DEF~", true},
+		{"max length", strings.Repeat("a", maxRequestIDLength), true},
+		{"empty", "", false},
+		{"too long", strings.Repeat("a", maxRequestIDLength+1), false},
+		{"space", "req 42", false},
+		{"newline", "req\n42", false},
+		{"tab", "req\t42", false},
+		{"delete", "req\x7f42", false},
+		{"non-ascii", "réq-42", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validRequestID(tt.id); got != tt.want {
+				t.Fatalf("validRequestID(%q) = %v, want %v", tt.id, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequestIDMiddleware(t *testing.T) {
+	tests := []struct {
+		name     string
+		incoming string
+		wantKept bool
+	}{
+		{"no header", "", false},
+		{"valid header", "client-req-42", true},
+		{"too long header", strings.Repeat("a", maxRequestIDLength+1), false},
+		{"space", "forged id", false},
+		{"control character", "forged\x01id", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logs bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&logs, nil))
+
+			app := fiber.New()
+			app.Use(func(c *fiber.Ctx) error {
+				c.SetUserContext(logging.WithLogger(c.UserContext(), logger))
+				return c.Next()
+			})
+			app.Use(RequestIDMiddleware())
+			RegisterRoutes(app, nil, []Route{{
+				Method: fiber.MethodGet, Path: "/items/:id", Access: AccessAnonymous,
+				Handler: func(c *fiber.Ctx) error {
+					logging.FromContext(c.UserContext()).Info("handled")
+					return c.SendString(RequestIDFromContext(c.UserContext()))
+				},
+			}})
+
+			req := httptest.NewRequest(http.MethodGet, "/items/7", nil)
+			if tt.incoming != "" {
+				req.Header.Set(RequestIDHeader, tt.incoming)
+			}
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatalf("request: %v", err)
+			}
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(resp.Body)
+
+			id := resp.Header.Get(RequestIDHeader)
+			if tt.wantKept && id != tt.incoming {
+				t.Fatalf("response request ID = %q, want the incoming %q", id, tt.incoming)
+			}
+			if !tt.wantKept && (id == tt.incoming || !validRequestID(id)) {
+				t.Fatalf("response request ID = %q, want a newly generated one", id)
+			}
+			if string(body) != id {
+				t.Errorf("request ID in context = %q, want %q", body, id)
+			}
+
+			var record map[string]any
+			if err := json.Unmarshal(logs.Bytes(), &record); err != nil {
+				t.Fatalf("invalid log record %q: %v", logs.String(), err)
+			}
+			if record["request_id"] != id || record["route"] != "/items/:id" {
+				t.Errorf("log record %v, want request_id %q and route /items/:id", record, id)
+			}
+		})
+	}
+}
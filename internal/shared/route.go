@@ -0,0 +1,87 @@
+package sharedModule
+
+import (
+	"fmt"
+
+	authPort "github.com/fbriansyah/go-modular/ports/auth"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Access declares who may call a route
+type Access int
+
+const (
+	// AccessAnonymous routes are reachable without a session
+	AccessAnonymous Access = iota
+
+	// AccessAuthenticated routes require a valid session
+	AccessAuthenticated
+
+	// AccessAdmin routes require a valid session of a user with the admin role
+	AccessAdmin
+)
+
+// Route describes a single HTTP endpoint and its access level
+type Route struct {
+	Method  string
+	Path    string
+	Access  Access
+	Handler fiber.Handler
+}
+
+// RegisterRoutes mounts routes on router, binding the request logger to the
+// matched route and placing authenticated and admin routes behind
+// authMiddleware. It panics when such a route has no middleware, so a missing
+// wiring fails at startup instead of exposing the route.
+func RegisterRoutes(router fiber.Router, authMiddleware fiber.Handler, routes []Route) {
+	for _, route := range routes {
+		switch route.Access {
+		case AccessAnonymous:
+			router.Add(route.Method, route.Path, bindRoute, route.Handler)
+		case AccessAuthenticated, AccessAdmin:
+			if authMiddleware == nil {
+				panic(fmt.Sprintf("route %s %s requires authentication but no auth middleware is configured", route.Method, route.Path))
+			}
+			handlers := []fiber.Handler{bindRoute, authMiddleware}
+			if route.Access == AccessAdmin {
+				handlers = append(handlers, requireAdmin)
+			}
+			router.Add(route.Method, route.Path, append(handlers, route.Handler)...)
+		default:
+			panic(fmt.Sprintf("route %s %s has unknown access level %d", route.Method, route.Path, route.Access))
+		}
+	}
+}
+
+// requireAdmin rejects callers without the admin role; it runs after the auth middleware
+func requireAdmin(c *fiber.Ctx) error {
+	principal, ok := PrincipalFromContext(c.UserContext())
+	if !ok || !principal.IsAdmin() {
+		return fiber.NewError(fiber.StatusForbidden, "admin role required")
+	}
+	return c.Next()
+}
+
+// RequireAuth returns a middleware that delegates to the authPort.AuthMiddleware
+// published in services. The middleware is resolved once by
+// services.ResolveDeferred after every module is initialized, so modules can
+// mount authenticated routes before the auth module and startup fails if no
+// module provides it.
+func RequireAuth(services *ServiceRegistry) fiber.Handler {
+	var middleware authPort.AuthMiddleware
+	services.deferResolve(func() error {
+		resolved, err := Resolve[authPort.AuthMiddleware](services)
+		if err != nil {
+			return fmt.Errorf("auth middleware required by authenticated routes: %w", err)
+		}
+		middleware = resolved
+		return nil
+	})
+
+	return func(c *fiber.Ctx) error {
+		if middleware == nil {
+			return fiber.NewError(fiber.StatusInternalServerError, "auth middleware is not resolved")
+		}
+		return middleware.RequireAuth(c)
+	}
+}
@@ -0,0 +1,88 @@
+package sharedModule
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	authPort "github.com/fbriansyah/go-modular/ports/auth"
+	"github.com/gofiber/fiber/v2"
+)
+
+// teapotAuth is an auth middleware that answers every request itself
+type teapotAuth struct{}
+
+func (teapotAuth) RequireAuth(c *fiber.Ctx) error {
+	return c.SendStatus(fiber.StatusTeapot)
+}
+
+func TestRequireAuthFailsStartupWithoutProvider(t *testing.T) {
+	services := NewServiceRegistry()
+	RequireAuth(services)
+
+	if err := services.ResolveDeferred(); !errors.Is(err, ErrServiceNotFound) {
+		t.Fatalf("ResolveDeferred: got %v, want ErrServiceNotFound", err)
+	}
+}
+
+func TestRequireAuthResolvesProviderAfterInit(t *testing.T) {
+	services := NewServiceRegistry()
+	handler := RequireAuth(services)
+
+	// the provider is published after the consumer mounted its routes
+	if err := Provide[authPort.AuthMiddleware](services, teapotAuth{}); err != nil {
+		t.Fatalf("Provide: %v", err)
+	}
+	if err := services.ResolveDeferred(); err != nil {
+		t.Fatalf("ResolveDeferred: %v", err)
+	}
+
+	app := fiber.New()
+	app.Get("/", handler)
+
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/", nil))
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	if resp.StatusCode != fiber.StatusTeapot {
+		t.Fatalf("got status %d, want the provider's %d", resp.StatusCode, fiber.StatusTeapot)
+	}
+}
+
+func TestAdminRoutesRejectNonAdmins(t *testing.T) {
+	// the fake auth middleware authenticates the role named in the X-Role header
+	fakeAuth := func(c *fiber.Ctx) error {
+		user := &userModel.User{ID: "u1", Role: userModel.UserRole(c.Get("X-Role"))}
+		c.SetUserContext(WithPrincipal(c.UserContext(), &Principal{User: user}))
+		return c.Next()
+	}
+
+	app := fiber.New()
+	RegisterRoutes(app, fakeAuth, []Route{
+		{Method: fiber.MethodGet, Path: "/admin", Access: AccessAdmin, Handler: func(c *fiber.Ctx) error {
+			return c.SendStatus(fiber.StatusOK)
+		}},
+	})
+
+	tests := []struct {
+		role userModel.UserRole
+		want int
+	}{
+		{userModel.UserRoleUser, fiber.StatusForbidden},
+		{userModel.UserRoleAdmin, fiber.StatusOK},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/admin", nil)
+		req.Header.Set("X-Role", string(tt.role))
+
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatalf("request: %v", err)
+		}
+		if resp.StatusCode != tt.want {
+			t.Errorf("role %q: got status %d, want %d", tt.role, resp.StatusCode, tt.want)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package sharedModule
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ErrServiceNotFound is returned when resolving a service nobody provided
+var ErrServiceNotFound = errors.New("service not found")
+
+// portsPackagePrefix is the import path prefix of the ports packages
+// (".../go-modular/ports/"), derived from this package's own import path
+var portsPackagePrefix = strings.TrimSuffix(reflect.TypeOf(ServiceRegistry{}).PkgPath(), "internal/shared") + "ports/"
+
+// ServiceRegistry is a typed container through which modules publish and
+// consume each other's services. Services are keyed by port interface type,
+// and only interfaces declared in ports/* are accepted, so a module never
+// depends on another module's concrete types.
+type ServiceRegistry struct {
+	mu       sync.RWMutex
+	services map[reflect.Type]any
+
+	// deferred resolve services needed by modules initialized before their provider
+	deferred []func() error
+}
+
+// NewServiceRegistry creates an empty service registry
+func NewServiceRegistry() *ServiceRegistry {
+	return &ServiceRegistry{
+		services: make(map[reflect.Type]any),
+	}
+}
+
+// Provide publishes service under the port interface T, e.g.
+//
+//	sharedModule.Provide[userPort.UserService](deps.Services, userService)
+func Provide[T any](r *ServiceRegistry, service T) error {
+	key, err := portType[T]()
+	if err != nil {
+		return err
+	}
+	if any(service) == nil {
+		return fmt.Errorf("cannot provide nil %s", key)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.services[key]; exists {
+		return fmt.Errorf("service %s is already provided", key)
+	}
+	r.services[key] = service
+
+	return nil
+}
+
+// Resolve returns the service published under the port interface T
+func Resolve[T any](r *ServiceRegistry) (T, error) {
+	var zero T
+
+	key, err := portType[T]()
+	if err != nil {
+		return zero, err
+	}
+
+	r.mu.RLock()
+	service, exists := r.services[key]
+	r.mu.RUnlock()
+
+	if !exists {
+		return zero, fmt.Errorf("%w: %s", ErrServiceNotFound, key)
+	}
+
+	return service.(T), nil
+}
+
+// deferResolve registers resolve to run in ResolveDeferred, once every
+// module is initialized
+func (r *ServiceRegistry) deferResolve(resolve func() error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.deferred = append(r.deferred, resolve)
+}
+
+// ResolveDeferred runs the resolutions registered while modules were
+// initializing and returns every failure, so a missing provider fails startup
+func (r *ServiceRegistry) ResolveDeferred() error {
+	r.mu.Lock()
+	deferred := r.deferred
+	r.deferred = nil
+	r.mu.Unlock()
+
+	var errs []error
+	for _, resolve := range deferred {
+		if err := resolve(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// portType returns the reflect.Type of T after checking it is a ports/* interface
+func portType[T any]() (reflect.Type, error) {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Interface {
+		return nil, fmt.Errorf("service key %s must be an interface", t)
+	}
+	if !strings.HasPrefix(t.PkgPath(), portsPackagePrefix) {
+		return nil, fmt.Errorf("service key %s must be declared in a ports package", t)
+	}
+	return t, nil
+}
@@ -0,0 +1,74 @@
+package sharedModule
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/fbriansyah/go-modular/config"
+	"github.com/fbriansyah/go-modular/pkg/tracing"
+	"github.com/gofiber/fiber/v2"
+)
+
+// NewTraceExporter returns the exporter selected by tracing.exporter:
+// "stdout" writes JSON lines to stdout, "none" (the default) disables export
+func NewTraceExporter(conf config.TracingConfig) (tracing.Exporter, error) {
+	switch strings.ToLower(conf.Exporter) {
+	case "", "none":
+		return nil, nil
+	case "stdout":
+		return tracing.NewStdoutExporter(os.Stdout), nil
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", conf.Exporter)
+	}
+}
+
+// TracingMiddleware starts a server span for every request, continuing the
+// trace from an incoming traceparent header, and echoes the span's
+// traceparent in the response
+func TracingMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx := c.UserContext()
+		if sc, err := tracing.ParseTraceparent(c.Get(tracing.TraceparentHeader)); err == nil {
+			ctx = tracing.ContextWithRemoteSpanContext(ctx, sc)
+		}
+
+		middlewareRoute := c.Route()
+		ctx, span := tracing.Start(ctx, "HTTP "+c.Method(),
+			tracing.WithSpanKind(tracing.SpanKindServer),
+			tracing.WithAttributes(
+				"http.method", c.Method(),
+				"http.target", c.OriginalURL(),
+			),
+		)
+		defer span.End()
+
+		c.SetUserContext(ctx)
+		c.Set(tracing.TraceparentHeader, span.SpanContext().Traceparent())
+
+		err := c.Next()
+		span.RecordError(err)
+		completeRequest(c, err)
+
+		route := c.Route().Path
+		if c.Route() == middlewareRoute {
+			route = unmatchedRoute
+		}
+		span.SetName(c.Method() + " " + route)
+		span.SetAttribute("http.route", route)
+		span.SetAttribute("http.status_code", c.Response().StatusCode())
+
+		return nil
+	}
+}
+
+// completeRequest hands a handler error to the app's error handler, so
+// middleware observing the response sees the status the client receives
+func completeRequest(c *fiber.Ctx, err error) {
+	if err == nil {
+		return
+	}
+	if handlerErr := c.App().ErrorHandler(c, err); handlerErr != nil {
+		_ = c.SendStatus(fiber.StatusInternalServerError)
+	}
+}
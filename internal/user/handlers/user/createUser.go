@@ -0,0 +1,27 @@
+package userHandler
+
+import (
+	"github.com/fbriansyah/go-modular/internal/model"
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	"github.com/gofiber/fiber/v2"
+)
+
+func (u *UserHandler) CreateUser(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	req := &userModel.CreateUserRequest{}
+	if err := c.BodyParser(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid request body")
+	}
+
+	user, err := u.userService.CreateUser(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(model.HttpResponse{
+		Code:    fiber.StatusCreated,
+		Message: "user created",
+		Data:    userModel.NewUserResponse(user),
+	})
+}
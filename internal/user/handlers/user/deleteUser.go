@@ -0,0 +1,20 @@
+package userHandler
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+func (u *UserHandler) DeleteUser(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := accessibleUserID(c)
+	if err != nil {
+		return err
+	}
+
+	if err := u.userService.DeleteUser(ctx, id); err != nil {
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
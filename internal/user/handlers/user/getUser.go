@@ -0,0 +1,27 @@
+package userHandler
+
+import (
+	"github.com/fbriansyah/go-modular/internal/model"
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	"github.com/gofiber/fiber/v2"
+)
+
+func (u *UserHandler) GetUser(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := accessibleUserID(c)
+	if err != nil {
+		return err
+	}
+
+	user, err := u.userService.GetUser(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(model.HttpResponse{
+		Code:    fiber.StatusOK,
+		Message: "OK",
+		Data:    userModel.NewUserResponse(user),
+	})
+}
@@ -0,0 +1,151 @@
+package userHandler
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	sharedModule "github.com/fbriansyah/go-modular/internal/shared"
+	"github.com/fbriansyah/go-modular/pkg/database"
+	userPort "github.com/fbriansyah/go-modular/ports/user"
+	"github.com/fbriansyah/go-modular/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+// testUserHeader names the user the fake auth middleware authenticates as
+const testUserHeader = "X-Test-User"
+
+// fakeUserService keeps users in memory
+type fakeUserService struct {
+	userPort.UserService
+	users map[string]*userModel.User
+}
+
+func (f *fakeUserService) CreateUser(_ context.Context, req *userModel.CreateUserRequest) (*userModel.User, error) {
+	user, err := userModel.NewUser(utils.GenerateUUID(), req.Email, req.Password, req.FirstName, req.LastName)
+	if err != nil {
+		return nil, err
+	}
+	f.users[user.ID] = user
+	return user, nil
+}
+
+func (f *fakeUserService) GetUser(_ context.Context, id string) (*userModel.User, error) {
+	user, ok := f.users[id]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return user, nil
+}
+
+func (f *fakeUserService) UpdateUser(_ context.Context, req *userModel.UpdateUserRequest) (*userModel.User, error) {
+	user, ok := f.users[req.ID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	if req.FirstName != nil {
+		user.FirstName = *req.FirstName
+	}
+	if req.Email != nil {
+		user.Email = *req.Email
+	}
+	user.Version++
+	return user, nil
+}
+
+func (f *fakeUserService) UpdateUserStatus(_ context.Context, req *userModel.UpdateUserStatusRequest) (*userModel.User, error) {
+	user, ok := f.users[req.ID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	user.Status = req.Status
+	user.Version++
+	return user, nil
+}
+
+func (f *fakeUserService) DeleteUser(_ context.Context, id string) error {
+	if _, ok := f.users[id]; !ok {
+		return database.ErrNotFound
+	}
+	delete(f.users, id)
+	return nil
+}
+
+func (f *fakeUserService) ListUser(context.Context, *userModel.ListUserQuery) ([]*userModel.User, int64, error) {
+	users := make([]*userModel.User, 0, len(f.users))
+	for _, user := range f.users {
+		users = append(users, user)
+	}
+	return users, int64(len(users)), nil
+}
+
+// addUser stores a valid user and returns it
+func (f *fakeUserService) addUser(t *testing.T, email string) *userModel.User {
+	t.Helper()
+
+	user, err := userModel.NewUser(utils.GenerateUUID(), email, "Secret123!", "Test", "User")
+	if err != nil {
+		t.Fatalf("NewUser: %v", err)
+	}
+	f.users[user.ID] = user
+	return user
+}
+
+// addAdmin stores a valid user with the admin role and returns it
+func (f *fakeUserService) addAdmin(t *testing.T, email string) *userModel.User {
+	t.Helper()
+
+	user := f.addUser(t, email)
+	user.Role = userModel.UserRoleAdmin
+	return user
+}
+
+// newTestApp mounts the user routes behind a fake auth middleware that
+// authenticates the user named in testUserHeader
+func newTestApp(service *fakeUserService) *fiber.App {
+	app := fiber.New(fiber.Config{ErrorHandler: sharedModule.NewErrorHandler(nil)})
+
+	fakeAuth := func(c *fiber.Ctx) error {
+		user, ok := service.users[c.Get(testUserHeader)]
+		if !ok {
+			return fiber.ErrUnauthorized
+		}
+		principal := &sharedModule.Principal{User: user, SessionID: "test-session"}
+		c.SetUserContext(sharedModule.WithPrincipal(c.UserContext(), principal))
+		return c.Next()
+	}
+
+	NewUserHandler(nil,
+		WithUserService(service),
+		WithAuthMiddleware(fakeAuth),
+	).SetupRoutes(app)
+
+	return app
+}
+
+// doRequest sends a JSON request as userID (anonymous when empty) and
+// returns the status code and body
+func doRequest(t *testing.T, app *fiber.App, method, path, userID, body string) (int, string) {
+	t.Helper()
+
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	if userID != "" {
+		req.Header.Set(testUserHeader, userID)
+	}
+
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	return resp.StatusCode, string(data)
+}
@@ -3,28 +3,71 @@ package userHandler
 import (
 	"github.com/fbriansyah/go-modular/internal/model"
 	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	sharedModule "github.com/fbriansyah/go-modular/internal/shared"
 	"github.com/gofiber/fiber/v2"
 )
 
 func (u *UserHandler) ListUser(c *fiber.Ctx) error {
-	ctx := c.Context()
+	ctx := c.UserContext()
 
 	query := &userModel.ListUserQuery{
 		FirstName: c.Query("first_name"),
 		LastName:  c.Query("last_name"),
 		Email:     c.Query("email"),
+		Status:    userModel.UserStatus(c.Query("status")),
+		Sort:      c.Query("sort"),
 		GeneralListQuery: model.GeneralListQuery{
-			Limit:  c.QueryInt("limit", 10),
+			Limit:  c.QueryInt("limit", 0),
 			Offset: c.QueryInt("offset", 0),
 		},
 	}
 
-	users, err := u.userService.ListUser(ctx, query)
+	var err error
+	if query.CreatedAfter, err = queryTime(c, "created_after"); err != nil {
+		return err
+	}
+	if query.CreatedBefore, err = queryTime(c, "created_before"); err != nil {
+		return err
+	}
+
+	// the presence of ?cursor= (even empty, for the first page) selects keyset pagination
+	if c.Request().URI().QueryArgs().Has("cursor") {
+		query.Cursor = c.Query("cursor")
+		return u.listUserByCursor(c, query)
+	}
+
+	users, total, err := u.userService.ListUser(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	next, prev := sharedModule.PaginationLinks(c, query.Limit, query.Offset, total)
+
+	return c.Status(fiber.StatusOK).JSON(model.ListResponse[*userModel.UserResponse]{
+		Code:    fiber.StatusOK,
+		Message: "OK",
+		Items:   userModel.NewUserResponses(users),
+		Total:   total,
+		Limit:   query.Limit,
+		Offset:  query.Offset,
+		Next:    next,
+		Prev:    prev,
+	})
+}
+
+func (u *UserHandler) listUserByCursor(c *fiber.Ctx, query *userModel.ListUserQuery) error {
+	ctx := c.UserContext()
+
+	users, nextCursor, err := u.userService.ListUserByCursor(ctx, query)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": err.Error(),
-		})
+		return err
 	}
 
-	return c.Status(fiber.StatusOK).JSON(users)
+	return c.Status(fiber.StatusOK).JSON(model.CursorListResponse[*userModel.UserResponse]{
+		Code:       fiber.StatusOK,
+		Message:    "OK",
+		Items:      userModel.NewUserResponses(users),
+		Limit:      query.Limit,
+		NextCursor: nextCursor,
+	})
 }
@@ -0,0 +1,82 @@
+package userHandler
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+)
+
+// forbiddenKeys must not appear anywhere in a user handler response
+var forbiddenKeys = []string{"password", "password_hash"}
+
+func TestUserResponsesOmitPassword(t *testing.T) {
+	service := &fakeUserService{users: map[string]*userModel.User{}}
+	app := newTestApp(service)
+
+	status, body := doRequest(t, app, http.MethodPost, "/v1/users", "",
+		`{"first_name": "Jane", "last_name": "Doe", "email": "jane@example.com", "password": "Secret123!"}`)
+	if status != http.StatusCreated {
+		t.Fatalf("create: got status %d: %s", status, body)
+	}
+	assertNoPasswordKeys(t, "create", body)
+
+	var created struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal([]byte(body), &created); err != nil || created.Data.ID == "" {
+		t.Fatalf("create: no user ID in response %s (%v)", body, err)
+	}
+	id := created.Data.ID
+	// listing is admin-only
+	service.users[id].Role = userModel.UserRoleAdmin
+
+	requests := []struct {
+		name, method, path, body string
+		want                     int
+	}{
+		{"get", http.MethodGet, "/v1/users/" + id, "", http.StatusOK},
+		{"list", http.MethodGet, "/v1/users", "", http.StatusOK},
+		{"update", http.MethodPatch, "/v1/users/" + id, `{"first_name": "Janet", "version": 1}`, http.StatusOK},
+	}
+	for _, r := range requests {
+		status, body := doRequest(t, app, r.method, r.path, id, r.body)
+		if status != r.want {
+			t.Fatalf("%s: got status %d, want %d: %s", r.name, status, r.want, body)
+		}
+		assertNoPasswordKeys(t, r.name, body)
+	}
+}
+
+// assertNoPasswordKeys fails when any object in the JSON body has a forbidden key
+func assertNoPasswordKeys(t *testing.T, name, body string) {
+	t.Helper()
+
+	var doc any
+	if err := json.Unmarshal([]byte(body), &doc); err != nil {
+		t.Fatalf("%s: invalid JSON %s: %v", name, body, err)
+	}
+
+	var walk func(v any)
+	walk = func(v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			for key, value := range v {
+				for _, forbidden := range forbiddenKeys {
+					if key == forbidden {
+						t.Errorf("%s: response contains %q: %s", name, key, body)
+					}
+				}
+				walk(value)
+			}
+		case []any:
+			for _, item := range v {
+				walk(item)
+			}
+		}
+	}
+	walk(doc)
+}
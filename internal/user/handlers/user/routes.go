@@ -1,6 +1,9 @@
 package userHandler
 
-import "github.com/gofiber/fiber/v2"
+import (
+	sharedModule "github.com/fbriansyah/go-modular/internal/shared"
+	"github.com/gofiber/fiber/v2"
+)
 
 func (u *UserHandler) SetupRoutes(httpApp *fiber.App) {
 	u.httpApp = httpApp
@@ -9,7 +12,18 @@ func (u *UserHandler) SetupRoutes(httpApp *fiber.App) {
 	u.setupUserRoutes(v1)
 }
 
+// setupUserRoutes mounts the user routes. Listing is admin-only; users may
+// read and delete their own account, admins any account. Profile and
+// password updates are always limited to the account holder; admins change
+// account status through the separate status route.
 func (u *UserHandler) setupUserRoutes(v1 fiber.Router) {
 	userGroup := v1.Group("/users")
-	userGroup.Get("", u.ListUser)
+	sharedModule.RegisterRoutes(userGroup, u.authMiddleware, []sharedModule.Route{
+		{Method: fiber.MethodGet, Path: "", Access: sharedModule.AccessAdmin, Handler: u.ListUser},
+		{Method: fiber.MethodPost, Path: "", Access: sharedModule.AccessAnonymous, Handler: u.CreateUser},
+		{Method: fiber.MethodGet, Path: "/:id", Access: sharedModule.AccessAuthenticated, Handler: u.GetUser},
+		{Method: fiber.MethodPatch, Path: "/:id", Access: sharedModule.AccessAuthenticated, Handler: u.UpdateUser},
+		{Method: fiber.MethodDelete, Path: "/:id", Access: sharedModule.AccessAuthenticated, Handler: u.DeleteUser},
+		{Method: fiber.MethodPatch, Path: "/:id/status", Access: sharedModule.AccessAdmin, Handler: u.UpdateUserStatus},
+	})
 }
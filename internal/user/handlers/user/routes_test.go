@@ -0,0 +1,129 @@
+package userHandler
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+)
+
+func TestUserRoutesRejectOtherUsers(t *testing.T) {
+	service := &fakeUserService{users: map[string]*userModel.User{}}
+	alice := service.addUser(t, "alice@example.com")
+	bob := service.addUser(t, "bob@example.com")
+	app := newTestApp(service)
+
+	tests := []struct {
+		name   string
+		method string
+		body   string
+	}{
+		{"get", http.MethodGet, ""},
+		{"patch", http.MethodPatch, `{"email": "mallory@example.com", "password": "Hijack123!", "version": 1}`},
+		{"delete", http.MethodDelete, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, body := doRequest(t, app, tt.method, "/v1/users/"+bob.ID, alice.ID, tt.body)
+			if status != http.StatusForbidden {
+				t.Fatalf("got status %d, want 403: %s", status, body)
+			}
+		})
+	}
+
+	if got := service.users[bob.ID]; got == nil || got.Email != "bob@example.com" {
+		t.Fatalf("bob was modified or deleted: %+v", got)
+	}
+}
+
+func TestUserRoutesAllowOwnAccount(t *testing.T) {
+	service := &fakeUserService{users: map[string]*userModel.User{}}
+	alice := service.addUser(t, "alice@example.com")
+	app := newTestApp(service)
+	path := "/v1/users/" + alice.ID
+
+	if status, body := doRequest(t, app, http.MethodGet, path, alice.ID, ""); status != http.StatusOK {
+		t.Fatalf("GET own user: got status %d: %s", status, body)
+	}
+	if status, body := doRequest(t, app, http.MethodPatch, path, alice.ID, `{"first_name": "Alicia", "version": 1}`); status != http.StatusOK {
+		t.Fatalf("PATCH own user: got status %d: %s", status, body)
+	}
+	if status, body := doRequest(t, app, http.MethodDelete, path, alice.ID, ""); status != http.StatusNoContent {
+		t.Fatalf("DELETE own user: got status %d: %s", status, body)
+	}
+}
+
+func TestUserListRequiresAdmin(t *testing.T) {
+	service := &fakeUserService{users: map[string]*userModel.User{}}
+	alice := service.addUser(t, "alice@example.com")
+	app := newTestApp(service)
+
+	if status, body := doRequest(t, app, http.MethodGet, "/v1/users", alice.ID, ""); status != http.StatusForbidden {
+		t.Fatalf("got status %d, want 403: %s", status, body)
+	}
+}
+
+func TestAdminListsEveryUser(t *testing.T) {
+	service := &fakeUserService{users: map[string]*userModel.User{}}
+	admin := service.addAdmin(t, "admin@example.com")
+	service.addUser(t, "alice@example.com")
+	service.addUser(t, "bob@example.com")
+	app := newTestApp(service)
+
+	status, body := doRequest(t, app, http.MethodGet, "/v1/users", admin.ID, "")
+	if status != http.StatusOK {
+		t.Fatalf("got status %d: %s", status, body)
+	}
+	for _, email := range []string{"admin@example.com", "alice@example.com", "bob@example.com"} {
+		if !strings.Contains(body, email) {
+			t.Errorf("listing is missing %s: %s", email, body)
+		}
+	}
+	if !strings.Contains(body, `"total":3`) {
+		t.Errorf("listing total is not 3: %s", body)
+	}
+}
+
+func TestAdminManagesOtherAccounts(t *testing.T) {
+	service := &fakeUserService{users: map[string]*userModel.User{}}
+	admin := service.addAdmin(t, "admin@example.com")
+	bob := service.addUser(t, "bob@example.com")
+	app := newTestApp(service)
+	path := "/v1/users/" + bob.ID
+
+	if status, body := doRequest(t, app, http.MethodGet, path, admin.ID, ""); status != http.StatusOK {
+		t.Fatalf("GET other user: got status %d: %s", status, body)
+	}
+	// profile and password belong to the account holder, even for admins
+	if status, body := doRequest(t, app, http.MethodPatch, path, admin.ID, `{"first_name": "Robert", "version": 1}`); status != http.StatusForbidden {
+		t.Fatalf("PATCH other user: got status %d, want 403: %s", status, body)
+	}
+	if status, body := doRequest(t, app, http.MethodDelete, path, admin.ID, ""); status != http.StatusNoContent {
+		t.Fatalf("DELETE other user: got status %d: %s", status, body)
+	}
+}
+
+func TestUserStatusRequiresAdmin(t *testing.T) {
+	service := &fakeUserService{users: map[string]*userModel.User{}}
+	admin := service.addAdmin(t, "admin@example.com")
+	alice := service.addUser(t, "alice@example.com")
+	app := newTestApp(service)
+	path := "/v1/users/" + alice.ID + "/status"
+	body := `{"status": "suspended", "version": 1}`
+
+	// users cannot change their own status
+	if status, resp := doRequest(t, app, http.MethodPatch, path, alice.ID, body); status != http.StatusForbidden {
+		t.Fatalf("own status: got status %d, want 403: %s", status, resp)
+	}
+	if alice.Status != userModel.UserStatusActive {
+		t.Fatalf("status changed by its owner to %q", alice.Status)
+	}
+
+	if status, resp := doRequest(t, app, http.MethodPatch, path, admin.ID, body); status != http.StatusOK {
+		t.Fatalf("admin: got status %d: %s", status, resp)
+	}
+	if alice.Status != userModel.UserStatusSuspended {
+		t.Fatalf("got status %q, want suspended", alice.Status)
+	}
+}
@@ -4,14 +4,16 @@ import (
 	"github.com/gofiber/fiber/v2"
 
 	"github.com/fbriansyah/go-modular/config"
-	userService "github.com/fbriansyah/go-modular/internal/user/services/user"
+	userPort "github.com/fbriansyah/go-modular/ports/user"
 )
 
 type Option func(*UserHandler)
 
 type UserHandler struct {
 	httpApp     *fiber.App
-	userService *userService.UserService
+	userService userPort.UserService
+
+	authMiddleware fiber.Handler
 }
 
 func NewUserHandler(conf *config.Config, opts ...Option) *UserHandler {
@@ -22,8 +24,14 @@ func NewUserHandler(conf *config.Config, opts ...Option) *UserHandler {
 	return userHandler
 }
 
-func WithUserService(userService *userService.UserService) Option {
+func WithUserService(userService userPort.UserService) Option {
 	return func(u *UserHandler) {
 		u.userService = userService
 	}
 }
+
+func WithAuthMiddleware(authMiddleware fiber.Handler) Option {
+	return func(u *UserHandler) {
+		u.authMiddleware = authMiddleware
+	}
+}
@@ -0,0 +1,37 @@
+package userHandler
+
+import (
+	"github.com/fbriansyah/go-modular/internal/model"
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	sharedModule "github.com/fbriansyah/go-modular/internal/shared"
+	"github.com/gofiber/fiber/v2"
+)
+
+func (u *UserHandler) UpdateUser(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	id, err := ownUserID(c)
+	if err != nil {
+		return err
+	}
+
+	req := &userModel.UpdateUserRequest{}
+	if err := c.BodyParser(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid request body")
+	}
+	req.ID = id
+	if principal, ok := sharedModule.PrincipalFromContext(ctx); ok {
+		req.SessionID = principal.SessionID
+	}
+
+	user, err := u.userService.UpdateUser(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(model.HttpResponse{
+		Code:    fiber.StatusOK,
+		Message: "user updated",
+		Data:    userModel.NewUserResponse(user),
+	})
+}
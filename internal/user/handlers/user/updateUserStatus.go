@@ -0,0 +1,29 @@
+package userHandler
+
+import (
+	"github.com/fbriansyah/go-modular/internal/model"
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	"github.com/gofiber/fiber/v2"
+)
+
+// UpdateUserStatus changes any user's status; the route is admin-only
+func (u *UserHandler) UpdateUserStatus(c *fiber.Ctx) error {
+	ctx := c.UserContext()
+
+	req := &userModel.UpdateUserStatusRequest{}
+	if err := c.BodyParser(req); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid request body")
+	}
+	req.ID = c.Params("id")
+
+	user, err := u.userService.UpdateUserStatus(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(model.HttpResponse{
+		Code:    fiber.StatusOK,
+		Message: "user status updated",
+		Data:    userModel.NewUserResponse(user),
+	})
+}
@@ -0,0 +1,50 @@
+package userHandler
+
+import (
+	"fmt"
+	"time"
+
+	sharedModule "github.com/fbriansyah/go-modular/internal/shared"
+	"github.com/fbriansyah/go-modular/pkg/database"
+	"github.com/gofiber/fiber/v2"
+)
+
+// errOtherAccount rejects access to any account but the caller's own
+var errOtherAccount = fiber.NewError(fiber.StatusForbidden, "users may only access their own account")
+
+// ownUserID returns the :id route parameter when it is the authenticated
+// user's own ID, and a 403 error otherwise
+func ownUserID(c *fiber.Ctx) (string, error) {
+	id := c.Params("id")
+
+	principal, ok := sharedModule.PrincipalFromContext(c.UserContext())
+	if !ok || principal.User == nil || principal.User.ID != id {
+		return "", errOtherAccount
+	}
+
+	return id, nil
+}
+
+// accessibleUserID returns the :id route parameter when it is the
+// authenticated user's own ID or the caller is an admin, and a 403 error otherwise
+func accessibleUserID(c *fiber.Ctx) (string, error) {
+	if principal, ok := sharedModule.PrincipalFromContext(c.UserContext()); ok && principal.IsAdmin() {
+		return c.Params("id"), nil
+	}
+	return ownUserID(c)
+}
+
+// queryTime parses an optional RFC 3339 timestamp query parameter
+func queryTime(c *fiber.Ctx, key string) (*time.Time, error) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s must be an RFC 3339 timestamp", database.ErrInvalidInput, key)
+	}
+
+	return &t, nil
+}
@@ -1,12 +1,15 @@
 package userModule
 
 import (
+	"context"
+
 	"github.com/fbriansyah/go-modular/config"
 	sharedModule "github.com/fbriansyah/go-modular/internal/shared"
 	userHandler "github.com/fbriansyah/go-modular/internal/user/handlers/user"
 	userRepository "github.com/fbriansyah/go-modular/internal/user/repositories/user"
 	userService "github.com/fbriansyah/go-modular/internal/user/services/user"
 	"github.com/fbriansyah/go-modular/pkg/database"
+	userPort "github.com/fbriansyah/go-modular/ports/user"
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -16,43 +19,59 @@ type UserModule struct {
 	db      *database.DB
 }
 
-type Option func(*UserModule)
-
-func WithDB(db *database.DB) Option {
-	return func(u *UserModule) {
-		u.db = db
-	}
+func NewUserModule() *UserModule {
+	return &UserModule{}
 }
 
-func WithHTTPApp(httpApp *fiber.App) Option {
-	return func(u *UserModule) {
-		u.httpApp = httpApp
-	}
+func (um *UserModule) Name() string {
+	return "user"
 }
 
-func NewUserModule(conf *config.Config, opts ...Option) *UserModule {
-	userModule := &UserModule{
-		conf: conf,
-	}
-	for _, opt := range opts {
-		opt(userModule)
-	}
-	return userModule
-}
+// Init wires the user components, mounts the routes and publishes userPort.UserService
+func (um *UserModule) Init(deps *sharedModule.Dependencies) error {
+	um.conf = deps.Config
+	um.httpApp = deps.HTTPApp
+	um.db = deps.DB
+
+	sharedModule.RegisterOutboxEvent[userPort.UserCreated](deps.Outbox)
+	sharedModule.RegisterOutboxEvent[userPort.UserUpdated](deps.Outbox)
+	sharedModule.RegisterOutboxEvent[userPort.UserDeleted](deps.Outbox)
 
-func (um *UserModule) Run() {
 	userRepo := userRepository.NewUserRepository(um.db)
 	userService := userService.NewUserService(
 		um.conf,
 		userService.WithUserRepository(userRepo),
+		userService.WithTransactionManager(database.NewTransactionManager(um.db)),
+		userService.WithEventPublisher(deps.Outbox),
 	)
 
+	if err := sharedModule.Provide[userPort.UserService](deps.Services, userService); err != nil {
+		return err
+	}
+
 	userHandler := userHandler.NewUserHandler(
 		um.conf,
 		userHandler.WithUserService(userService),
+		userHandler.WithAuthMiddleware(sharedModule.RequireAuth(deps.Services)),
 	)
 	userHandler.SetupRoutes(um.httpApp)
 
+	return nil
+}
+
+func (um *UserModule) Start(ctx context.Context) error {
+	return nil
+}
+
+func (um *UserModule) Stop(ctx context.Context) error {
+	return nil
+}
+
+func (um *UserModule) Health(ctx context.Context) sharedModule.HealthStatus {
+	if um.httpApp == nil {
+		return sharedModule.Unhealthy("module is not initialized")
+	}
+	return sharedModule.Healthy("")
 }
 
-var _ sharedModule.Application = (*UserModule)(nil)
+var _ sharedModule.Module = (*UserModule)(nil)
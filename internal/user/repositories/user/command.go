@@ -3,6 +3,7 @@ package userRepository
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	userModel "github.com/fbriansyah/go-modular/internal/model/user"
 	"github.com/fbriansyah/go-modular/pkg/database"
@@ -11,17 +12,19 @@ import (
 
 // Create implements userPort.UserRepository.
 // Subtle: this method shadows the method (BaseRepository).Create of UserRepository.BaseRepository.
-func (u *UserRepository) Create(ctx context.Context, entity *userModel.User) error {
+func (u *UserRepository) Create(ctx context.Context, entity *userModel.User) (err error) {
+	defer u.Observe("Create", time.Now(), &err)
+
 	// Generate UUID if not provided
 	if entity.ID == "" {
 		entity.ID = utils.GenerateUUID()
 	}
 
 	query := `
-		INSERT INTO users (id, email, password_hash, first_name, last_name, status, created_at, updated_at, version)
-		VALUES (:id, :email, :password, :first_name, :last_name, :status, :created_at, :updated_at, :version)`
+		INSERT INTO users (id, email, password_hash, first_name, last_name, status, role, created_at, updated_at, version)
+		VALUES (:id, :email, :password, :first_name, :last_name, :status, :role, :created_at, :updated_at, :version)`
 
-	err := u.BaseRepository.Create(ctx, entity, query)
+	err = u.BaseRepository.Create(ctx, entity, query)
 	if err != nil {
 		return u.handleError("Create", err)
 	}
@@ -31,10 +34,12 @@ func (u *UserRepository) Create(ctx context.Context, entity *userModel.User) err
 
 // Delete implements userPort.UserRepository.
 // Subtle: this method shadows the method (BaseRepository).Delete of UserRepository.BaseRepository.
-func (u *UserRepository) Delete(ctx context.Context, id string) error {
+func (u *UserRepository) Delete(ctx context.Context, id string) (err error) {
+	defer u.Observe("Delete", time.Now(), &err)
+
 	query := `DELETE FROM users WHERE id = $1`
 
-	err := u.BaseRepository.Delete(ctx, id, query)
+	err = u.BaseRepository.Delete(ctx, id, query)
 	if err != nil {
 		return u.handleError("Delete", err)
 	}
@@ -44,7 +49,9 @@ func (u *UserRepository) Delete(ctx context.Context, id string) error {
 
 // Update implements userPort.UserRepository.
 // Subtle: this method shadows the method (BaseRepository).Update of UserRepository.BaseRepository.
-func (u *UserRepository) Update(ctx context.Context, entity *userModel.User) error {
+func (u *UserRepository) Update(ctx context.Context, entity *userModel.User) (err error) {
+	defer u.Observe("Update", time.Now(), &err)
+
 	query := `
 		UPDATE users 
 		SET email = :email, 
@@ -58,7 +65,6 @@ func (u *UserRepository) Update(ctx context.Context, entity *userModel.User) err
 
 	tx := database.GetTxFromContext(ctx)
 	var result sql.Result
-	var err error
 
 	if tx != nil {
 		result, err = tx.NamedExecContext(ctx, query, entity)
@@ -26,6 +26,8 @@ func (r *UserRepository) handleError(operation string, err error) error {
 			return database.NewDatabaseErrorWithCode(operation, "users", "23503", "foreign key constraint violation", database.ErrForeignKeyViolation)
 		case "23514": // check_violation
 			return database.NewDatabaseErrorWithCode(operation, "users", "23514", "check constraint violation", database.ErrInvalidInput)
+		case "22P02": // invalid_text_representation, e.g. malformed UUID
+			return database.NewDatabaseErrorWithCode(operation, "users", "22P02", "invalid input syntax", database.ErrInvalidInput)
 		}
 	}
 
@@ -3,24 +3,31 @@ package userRepository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	userModel "github.com/fbriansyah/go-modular/internal/model/user"
 	"github.com/fbriansyah/go-modular/pkg/database"
 )
 
 var (
-	selectFields = "id, email, password_hash as password, first_name, last_name, status, created_at, updated_at, version"
+	selectFields = "id, email, password_hash as password, first_name, last_name, status, role, created_at, updated_at, version"
 )
 
 // Count implements userPort.UserRepository.
 // Subtle: this method shadows the method (BaseRepository).Count of UserRepository.BaseRepository.
 func (u *UserRepository) Count(ctx context.Context, filter *userModel.User) (int64, error) {
+	return u.CountFiltered(ctx, userModel.NewUserFilter(filter))
+}
+
+// CountFiltered returns the number of users matching filter
+func (u *UserRepository) CountFiltered(ctx context.Context, filter *userModel.UserFilter) (_ int64, err error) {
+	defer u.Observe("CountFiltered", time.Now(), &err)
+
 	query, args := u.buildCountQuery(filter)
 
 	var count int64
 	tx := database.GetTxFromContext(ctx)
 
-	var err error
 	if tx != nil {
 		err = tx.GetContext(ctx, &count, query, args...)
 	} else {
@@ -28,7 +35,7 @@ func (u *UserRepository) Count(ctx context.Context, filter *userModel.User) (int
 	}
 
 	if err != nil {
-		return 0, u.handleError("Count", err)
+		return 0, u.handleError("CountFiltered", err)
 	}
 
 	return count, nil
@@ -36,7 +43,9 @@ func (u *UserRepository) Count(ctx context.Context, filter *userModel.User) (int
 
 // GetByID implements userPort.UserRepository.
 // Subtle: this method shadows the method (BaseRepository).GetByID of UserRepository.BaseRepository.
-func (u *UserRepository) GetByID(ctx context.Context, id string) (*userModel.User, error) {
+func (u *UserRepository) GetByID(ctx context.Context, id string) (_ *userModel.User, err error) {
+	defer u.Observe("GetByID", time.Now(), &err)
+
 	query := fmt.Sprintf("SELECT %s FROM users WHERE id = $1", selectFields)
 
 	user, err := u.BaseRepository.GetByID(ctx, id, query)
@@ -50,12 +59,18 @@ func (u *UserRepository) GetByID(ctx context.Context, id string) (*userModel.Use
 // List implements userPort.UserRepository.
 // Subtle: this method shadows the method (BaseRepository).List of UserRepository.BaseRepository.
 func (u *UserRepository) List(ctx context.Context, filter *userModel.User, limit int, offset int) ([]*userModel.User, error) {
+	return u.Search(ctx, userModel.NewUserFilter(filter), limit, offset)
+}
+
+// Search returns one offset page of users matching filter, in filter.Sort order
+func (u *UserRepository) Search(ctx context.Context, filter *userModel.UserFilter, limit int, offset int) (_ []*userModel.User, err error) {
+	defer u.Observe("Search", time.Now(), &err)
+
 	query, args := u.buildListQuery(filter, limit, offset)
 
 	var users []*userModel.User
 	tx := database.GetTxFromContext(ctx)
 
-	var err error
 	if tx != nil {
 		err = tx.SelectContext(ctx, &users, query, args...)
 	} else {
@@ -63,7 +78,30 @@ func (u *UserRepository) List(ctx context.Context, filter *userModel.User, limit
 	}
 
 	if err != nil {
-		return nil, u.handleError("List", err)
+		return nil, u.handleError("Search", err)
+	}
+
+	return users, nil
+}
+
+// ListAfter returns up to limit users whose ID sorts before afterID, newest
+// first. An empty afterID starts from the newest user.
+func (u *UserRepository) ListAfter(ctx context.Context, filter *userModel.UserFilter, afterID string, limit int) (_ []*userModel.User, err error) {
+	defer u.Observe("ListAfter", time.Now(), &err)
+
+	query, args := u.buildKeysetQuery(filter, afterID, limit)
+
+	var users []*userModel.User
+	tx := database.GetTxFromContext(ctx)
+
+	if tx != nil {
+		err = tx.SelectContext(ctx, &users, query, args...)
+	} else {
+		err = u.GetDB().SelectContext(ctx, &users, query, args...)
+	}
+
+	if err != nil {
+		return nil, u.handleError("ListAfter", err)
 	}
 
 	return users, nil
@@ -71,13 +109,14 @@ func (u *UserRepository) List(ctx context.Context, filter *userModel.User, limit
 
 // Exists implements userPort.UserRepository.
 // Subtle: this method shadows the method (BaseRepository).Exists of UserRepository.BaseRepository.
-func (u *UserRepository) Exists(ctx context.Context, id string) (bool, error) {
+func (u *UserRepository) Exists(ctx context.Context, id string) (_ bool, err error) {
+	defer u.Observe("Exists", time.Now(), &err)
+
 	query := `SELECT EXISTS(SELECT 1 FROM users WHERE id = $1)`
 
 	var exists bool
 	tx := database.GetTxFromContext(ctx)
 
-	var err error
 	if tx != nil {
 		err = tx.GetContext(ctx, &exists, query, id)
 	} else {
@@ -91,17 +130,18 @@ func (u *UserRepository) Exists(ctx context.Context, id string) (bool, error) {
 	return exists, nil
 }
 
-func (u *UserRepository) GetByEmail(ctx context.Context, email string) (*userModel.User, error) {
+func (u *UserRepository) GetByEmail(ctx context.Context, email string) (_ *userModel.User, err error) {
+	defer u.Observe("GetByEmail", time.Now(), &err)
+
 	query := fmt.Sprintf("SELECT %s FROM users WHERE email = $1", selectFields)
 
 	user := &userModel.User{}
 
 	tx := database.GetTxFromContext(ctx)
-	var err error
 	if tx != nil {
-		err = tx.GetContext(ctx, &user, query, email)
+		err = tx.GetContext(ctx, user, query, email)
 	} else {
-		err = u.GetDB().GetContext(ctx, &user, query, email)
+		err = u.GetDB().GetContext(ctx, user, query, email)
 	}
 
 	if err != nil {
@@ -5,12 +5,16 @@ import (
 	"strings"
 
 	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	"github.com/fbriansyah/go-modular/pkg/database"
 )
 
+// defaultOrderBy is used when the filter does not specify a sort order
+const defaultOrderBy = "created_at DESC"
+
 // buildListQuery constructs the SQL query for listing users with filters
-func (r *UserRepository) buildListQuery(filter *userModel.User, limit, offset int) (string, []interface{}) {
+func (r *UserRepository) buildListQuery(filter *userModel.UserFilter, limit, offset int) (string, []interface{}) {
 	query := `
-		SELECT id, email, password_hash as password, first_name, last_name, status, created_at, updated_at, version
+		SELECT id, email, password_hash as password, first_name, last_name, status, role, created_at, updated_at, version
 		FROM users`
 
 	whereClause, args := r.buildWhereClause(filter)
@@ -18,7 +22,7 @@ func (r *UserRepository) buildListQuery(filter *userModel.User, limit, offset in
 		query += " WHERE " + whereClause
 	}
 
-	query += " ORDER BY created_at DESC"
+	query += " ORDER BY " + r.buildOrderBy(filter)
 
 	// Add pagination
 	argIndex := len(args) + 1
@@ -28,8 +32,32 @@ func (r *UserRepository) buildListQuery(filter *userModel.User, limit, offset in
 	return query, args
 }
 
+// buildKeysetQuery constructs the SQL query for listing users after the given
+// ID. UUIDv7 IDs sort by creation time, so ordering by id DESC matches the
+// offset listing's created_at DESC ordering without an OFFSET scan.
+func (r *UserRepository) buildKeysetQuery(filter *userModel.UserFilter, afterID string, limit int) (string, []interface{}) {
+	query := fmt.Sprintf("SELECT %s FROM users", selectFields)
+
+	whereClause, args := r.buildWhereClause(filter)
+	if afterID != "" {
+		if whereClause != "" {
+			whereClause += " AND "
+		}
+		args = append(args, afterID)
+		whereClause += fmt.Sprintf("id < $%d", len(args))
+	}
+	if whereClause != "" {
+		query += " WHERE " + whereClause
+	}
+
+	args = append(args, limit)
+	query += fmt.Sprintf(" ORDER BY id DESC LIMIT $%d", len(args))
+
+	return query, args
+}
+
 // buildCountQuery constructs the SQL query for counting users with filters
-func (r *UserRepository) buildCountQuery(filter *userModel.User) (string, []interface{}) {
+func (r *UserRepository) buildCountQuery(filter *userModel.UserFilter) (string, []interface{}) {
 	query := "SELECT COUNT(*) FROM users"
 
 	whereClause, args := r.buildWhereClause(filter)
@@ -40,8 +68,21 @@ func (r *UserRepository) buildCountQuery(filter *userModel.User) (string, []inte
 	return query, args
 }
 
+// buildOrderBy constructs the ORDER BY body. Sort columns come from
+// userModel.SortableFields, and id is appended as a tie-breaker so pages are stable.
+func (r *UserRepository) buildOrderBy(filter *userModel.UserFilter) string {
+	if len(filter.Sort) == 0 {
+		return defaultOrderBy
+	}
+
+	fields := append([]database.SortField{}, filter.Sort...)
+	fields = append(fields, database.SortField{Column: "id", Desc: true})
+
+	return database.OrderByClause(fields)
+}
+
 // buildWhereClause constructs the WHERE clause for filtering
-func (r *UserRepository) buildWhereClause(filter *userModel.User) (string, []interface{}) {
+func (r *UserRepository) buildWhereClause(filter *userModel.UserFilter) (string, []interface{}) {
 	var conditions []string
 	var args []interface{}
 	argIndex := 1
@@ -70,5 +111,17 @@ func (r *UserRepository) buildWhereClause(filter *userModel.User) (string, []int
 		argIndex++
 	}
 
+	if filter.CreatedAfter != nil {
+		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", argIndex))
+		args = append(args, *filter.CreatedAfter)
+		argIndex++
+	}
+
+	if filter.CreatedBefore != nil {
+		conditions = append(conditions, fmt.Sprintf("created_at < $%d", argIndex))
+		args = append(args, *filter.CreatedBefore)
+		argIndex++
+	}
+
 	return strings.Join(conditions, " AND "), args
 }
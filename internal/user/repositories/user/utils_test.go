@@ -0,0 +1,49 @@
+package userRepository
+
+import (
+	"strings"
+	"testing"
+
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	"github.com/fbriansyah/go-modular/pkg/database"
+)
+
+func TestBuildOrderBy(t *testing.T) {
+	tests := []struct {
+		spec string
+		want string
+	}{
+		{"", defaultOrderBy},
+		{"email", "email ASC, id DESC"},
+		{"-created_at", "created_at DESC, id DESC"},
+		{"status,-last_name", "status ASC, last_name DESC, id DESC"},
+	}
+	for _, tt := range tests {
+		sort, err := database.ParseSort(tt.spec, userModel.SortableFields)
+		if err != nil {
+			t.Fatalf("ParseSort(%q): %v", tt.spec, err)
+		}
+
+		got := (&UserRepository{}).buildOrderBy(&userModel.UserFilter{Sort: sort})
+		if got != tt.want {
+			t.Errorf("buildOrderBy(%q) = %q, want %q", tt.spec, got, tt.want)
+		}
+	}
+}
+
+func TestListQueryNeverInterpolatesUserInput(t *testing.T) {
+	injection := "x'; drop table users; --"
+	filter := &userModel.UserFilter{FirstName: injection, Email: injection}
+
+	query, args := (&UserRepository{}).buildListQuery(filter, 10, 0)
+	if strings.Contains(query, injection) || strings.Contains(strings.ToLower(query), "drop table") {
+		t.Fatalf("filter value interpolated into query:\n%s", query)
+	}
+	if len(args) != 4 {
+		t.Fatalf("got %d args, want 2 filters plus limit and offset", len(args))
+	}
+
+	if _, err := database.ParseSort("id;drop table users", userModel.SortableFields); err == nil {
+		t.Fatal("ParseSort accepted an injected sort spec")
+	}
+}
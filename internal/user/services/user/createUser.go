@@ -3,16 +3,28 @@ package userService
 import (
 	"context"
 	"errors"
+	"time"
 
 	userModel "github.com/fbriansyah/go-modular/internal/model/user"
 	"github.com/fbriansyah/go-modular/pkg/database"
+	"github.com/fbriansyah/go-modular/pkg/tracing"
+	userPort "github.com/fbriansyah/go-modular/ports/user"
 	"github.com/fbriansyah/go-modular/utils"
 )
 
-func (s *UserService) CreateUser(ctx context.Context, req *userModel.CreateUserRequest) (*userModel.User, error) {
+func (s *UserService) CreateUser(ctx context.Context, req *userModel.CreateUserRequest) (_ *userModel.User, err error) {
+	ctx, span := tracing.Start(ctx, "UserService.CreateUser")
+	defer span.EndWithError(&err)
+
+	uuid := utils.GenerateUUID()
+	user, err := userModel.NewUser(uuid, req.Email, req.Password, req.FirstName, req.LastName)
+	if err != nil {
+		return nil, err
+	}
+
 	// check email is exist
 	exists, err := s.userRepository.GetByEmail(ctx, req.Email)
-	if err != nil {
+	if err != nil && !database.IsNotFoundError(err) {
 		return nil, err
 	}
 
@@ -20,13 +32,17 @@ func (s *UserService) CreateUser(ctx context.Context, req *userModel.CreateUserR
 		return nil, errors.Join(errors.New("email already exists"), database.ErrDuplicateKey)
 	}
 
-	uuid := utils.GenerateUUID()
-	user, err := userModel.NewUser(uuid, req.Email, req.Password, req.FirstName, req.LastName)
-	if err != nil {
-		return nil, err
-	}
+	err = s.inTransaction(ctx, func(ctx context.Context) error {
+		if err := s.userRepository.Create(ctx, user); err != nil {
+			return err
+		}
 
-	err = s.userRepository.Create(ctx, user)
+		return s.publish(ctx, userPort.UserCreated{
+			UserID:     user.ID,
+			Email:      user.Email,
+			OccurredAt: time.Now(),
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,25 @@
+package userService
+
+import (
+	"context"
+	"time"
+
+	"github.com/fbriansyah/go-modular/pkg/tracing"
+	userPort "github.com/fbriansyah/go-modular/ports/user"
+)
+
+func (s *UserService) DeleteUser(ctx context.Context, id string) (err error) {
+	ctx, span := tracing.Start(ctx, "UserService.DeleteUser")
+	defer span.EndWithError(&err)
+
+	return s.inTransaction(ctx, func(ctx context.Context) error {
+		if err := s.userRepository.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return s.publish(ctx, userPort.UserDeleted{
+			UserID:     id,
+			OccurredAt: time.Now(),
+		})
+	})
+}
@@ -0,0 +1,25 @@
+package userService
+
+import (
+	"context"
+
+	sharedModule "github.com/fbriansyah/go-modular/internal/shared"
+)
+
+// inTransaction runs fn in a transaction when a transaction manager is configured
+func (s *UserService) inTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.txManager == nil {
+		return fn(ctx)
+	}
+	return s.txManager.ExecuteInTransaction(ctx, fn)
+}
+
+// publish announces a change. Call it inside the transaction persisting the
+// change so that, with the outbox, the event is stored if and only if the
+// change commits.
+func (s *UserService) publish(ctx context.Context, event sharedModule.Event) error {
+	if s.events == nil {
+		return nil
+	}
+	return s.events.Publish(ctx, event)
+}
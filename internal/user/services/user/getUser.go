@@ -0,0 +1,22 @@
+package userService
+
+import (
+	"context"
+
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	"github.com/fbriansyah/go-modular/pkg/tracing"
+)
+
+func (s *UserService) GetUser(ctx context.Context, id string) (_ *userModel.User, err error) {
+	ctx, span := tracing.Start(ctx, "UserService.GetUser")
+	defer span.EndWithError(&err)
+
+	return s.userRepository.GetByID(ctx, id)
+}
+
+func (s *UserService) GetUserByEmail(ctx context.Context, email string) (_ *userModel.User, err error) {
+	ctx, span := tracing.Start(ctx, "UserService.GetUserByEmail")
+	defer span.EndWithError(&err)
+
+	return s.userRepository.GetByEmail(ctx, email)
+}
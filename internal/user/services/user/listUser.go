@@ -2,25 +2,37 @@ package userService
 
 import (
 	"context"
-	"log/slog"
 
 	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	"github.com/fbriansyah/go-modular/pkg/logging"
+	"github.com/fbriansyah/go-modular/pkg/tracing"
 )
 
-func (s *UserService) ListUser(ctx context.Context, query *userModel.ListUserQuery) ([]*userModel.User, error) {
-	slog.Info("ListUser", "query", query)
+// ListUser returns one page of users matching query together with the total
+// number of matches. query.Limit is clamped to the configured maximum.
+func (s *UserService) ListUser(ctx context.Context, query *userModel.ListUserQuery) (_ []*userModel.User, _ int64, err error) {
+	ctx, span := tracing.Start(ctx, "UserService.ListUser")
+	defer span.EndWithError(&err)
 
-	filter := &userModel.User{
-		FirstName: query.FirstName,
-		LastName:  query.LastName,
-		Email:     query.Email,
+	query.Normalize(s.pageLimits())
+	logging.FromContext(ctx).Info("ListUser", "query", query)
+
+	filter, err := buildFilter(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	users, err := s.userRepository.Search(ctx, filter, query.Limit, query.Offset)
+	if err != nil {
+		logging.FromContext(ctx).Error("ListUser", "query", query, "error", err)
+		return nil, 0, err
 	}
 
-	users, err := s.userRepository.List(ctx, filter, query.Limit, query.Offset)
+	total, err := s.userRepository.CountFiltered(ctx, filter)
 	if err != nil {
-		slog.Error("ListUser", "query", query, "error", err)
-		return nil, err
+		logging.FromContext(ctx).Error("ListUser", "query", query, "error", err)
+		return nil, 0, err
 	}
 
-	return users, nil
+	return users, total, nil
 }
@@ -0,0 +1,51 @@
+package userService
+
+import (
+	"context"
+	"errors"
+
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	"github.com/fbriansyah/go-modular/pkg/database"
+	"github.com/fbriansyah/go-modular/pkg/logging"
+	"github.com/fbriansyah/go-modular/pkg/tracing"
+)
+
+// ListUserByCursor returns one keyset page of users after query.Cursor and the
+// cursor for the following page, which is empty on the last page.
+func (s *UserService) ListUserByCursor(ctx context.Context, query *userModel.ListUserQuery) (_ []*userModel.User, _ string, err error) {
+	ctx, span := tracing.Start(ctx, "UserService.ListUserByCursor")
+	defer span.EndWithError(&err)
+
+	query.Normalize(s.pageLimits())
+	logging.FromContext(ctx).Info("ListUserByCursor", "query", query)
+
+	var afterID string
+	if query.Cursor != "" {
+		var err error
+		afterID, err = database.DecodeCursor[string](query.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	// keyset pages are ordered by id, so a custom sort cannot be honoured
+	if query.Sort != "" {
+		return nil, "", errors.Join(errors.New("sort is not supported with cursor pagination"), database.ErrInvalidInput)
+	}
+
+	filter, err := buildFilter(query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// fetch one extra row to learn whether another page exists
+	users, err := s.userRepository.ListAfter(ctx, filter, afterID, query.Limit+1)
+	if err != nil {
+		logging.FromContext(ctx).Error("ListUserByCursor", "query", query, "error", err)
+		return nil, "", err
+	}
+
+	return database.KeysetPage(users, query.Limit, func(u *userModel.User) string {
+		return u.ID
+	})
+}
@@ -0,0 +1,43 @@
+package userService
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fbriansyah/go-modular/config"
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	"github.com/fbriansyah/go-modular/pkg/database"
+	userPort "github.com/fbriansyah/go-modular/ports/user"
+)
+
+// fakeUserRepository fails the test if the service reaches the database
+type fakeUserRepository struct {
+	userPort.UserRepository
+	t *testing.T
+}
+
+func (f *fakeUserRepository) ListAfter(context.Context, *userModel.UserFilter, string, int) ([]*userModel.User, error) {
+	f.t.Fatal("ListAfter called for an invalid query")
+	return nil, nil
+}
+
+func TestListUserByCursorRejectsInvalidQueries(t *testing.T) {
+	service := NewUserService(&config.Config{}, WithUserRepository(&fakeUserRepository{t: t}))
+
+	tests := []struct {
+		name  string
+		query *userModel.ListUserQuery
+	}{
+		{"sort", &userModel.ListUserQuery{Sort: "email"}},
+		{"malformed cursor", &userModel.ListUserQuery{Cursor: "not*a*cursor"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := service.ListUserByCursor(context.Background(), tt.query)
+			if !errors.Is(err, database.ErrInvalidInput) {
+				t.Fatalf("got %v, want ErrInvalidInput", err)
+			}
+		})
+	}
+}
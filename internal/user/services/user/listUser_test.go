@@ -0,0 +1,67 @@
+package userService
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fbriansyah/go-modular/config"
+	"github.com/fbriansyah/go-modular/constants"
+	"github.com/fbriansyah/go-modular/internal/model"
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	userPort "github.com/fbriansyah/go-modular/ports/user"
+)
+
+// limitRecordingRepository records the page size the service asks for
+type limitRecordingRepository struct {
+	userPort.UserRepository
+	limit int
+}
+
+func (r *limitRecordingRepository) Search(_ context.Context, _ *userModel.UserFilter, limit, _ int) ([]*userModel.User, error) {
+	r.limit = limit
+	return nil, nil
+}
+
+func (r *limitRecordingRepository) CountFiltered(context.Context, *userModel.UserFilter) (int64, error) {
+	return 0, nil
+}
+
+func (r *limitRecordingRepository) ListAfter(_ context.Context, _ *userModel.UserFilter, _ string, limit int) ([]*userModel.User, error) {
+	r.limit = limit
+	return nil, nil
+}
+
+func TestListUserPageLimits(t *testing.T) {
+	tests := []struct {
+		name      string
+		conf      *config.Config
+		limit     int
+		wantLimit int
+	}{
+		{"no config uses the default", nil, 0, constants.DefaultPageLimit},
+		{"no config clamps to the default maximum", nil, constants.DefaultMaxPageLimit + 1, constants.DefaultMaxPageLimit},
+		{"configured default", &config.Config{Pagination: config.PaginationConfig{DefaultLimit: 5, MaxLimit: 20}}, 0, 5},
+		{"configured maximum", &config.Config{Pagination: config.PaginationConfig{DefaultLimit: 5, MaxLimit: 20}}, 50, 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repository := &limitRecordingRepository{}
+			service := NewUserService(tt.conf, WithUserRepository(repository))
+
+			if _, _, err := service.ListUser(context.Background(), &userModel.ListUserQuery{GeneralListQuery: model.GeneralListQuery{Limit: tt.limit}}); err != nil {
+				t.Fatalf("ListUser: %v", err)
+			}
+			if repository.limit != tt.wantLimit {
+				t.Errorf("ListUser asked for %d users, want %d", repository.limit, tt.wantLimit)
+			}
+
+			// the cursor listing fetches one extra row to detect the next page
+			if _, _, err := service.ListUserByCursor(context.Background(), &userModel.ListUserQuery{GeneralListQuery: model.GeneralListQuery{Limit: tt.limit}}); err != nil {
+				t.Fatalf("ListUserByCursor: %v", err)
+			}
+			if repository.limit != tt.wantLimit+1 {
+				t.Errorf("ListUserByCursor asked for %d users, want %d", repository.limit, tt.wantLimit+1)
+			}
+		})
+	}
+}
@@ -2,12 +2,16 @@ package userService
 
 import (
 	"github.com/fbriansyah/go-modular/config"
+	sharedModule "github.com/fbriansyah/go-modular/internal/shared"
+	"github.com/fbriansyah/go-modular/pkg/database"
 	userPort "github.com/fbriansyah/go-modular/ports/user"
 )
 
 type UserService struct {
 	conf           *config.Config
 	userRepository userPort.UserRepository
+	txManager      *database.TransactionManager
+	events         sharedModule.EventPublisher
 }
 
 type Option func(*UserService)
@@ -26,4 +30,18 @@ func WithUserRepository(userRepository userPort.UserRepository) Option {
 	}
 }
 
+// WithTransactionManager makes writes and the events they publish atomic
+func WithTransactionManager(txManager *database.TransactionManager) Option {
+	return func(u *UserService) {
+		u.txManager = txManager
+	}
+}
+
+// WithEventPublisher sets where UserCreated, UserUpdated and UserDeleted are published
+func WithEventPublisher(events sharedModule.EventPublisher) Option {
+	return func(u *UserService) {
+		u.events = events
+	}
+}
+
 var _ userPort.UserService = (*UserService)(nil)
@@ -0,0 +1,83 @@
+package userService
+
+import (
+	"context"
+	"time"
+
+	"github.com/fbriansyah/go-modular/internal/model"
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	"github.com/fbriansyah/go-modular/pkg/database"
+	"github.com/fbriansyah/go-modular/pkg/tracing"
+	userPort "github.com/fbriansyah/go-modular/ports/user"
+)
+
+func (s *UserService) UpdateUser(ctx context.Context, req *userModel.UpdateUserRequest) (_ *userModel.User, err error) {
+	ctx, span := tracing.Start(ctx, "UserService.UpdateUser")
+	defer span.EndWithError(&err)
+
+	user, err := s.userRepository.GetByID(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// reject stale writes before touching the row
+	if user.Version != req.Version {
+		return nil, database.ErrOptimisticLock
+	}
+	previousStatus := user.Status
+
+	if req.FirstName != nil {
+		user.FirstName = *req.FirstName
+	}
+	if req.LastName != nil {
+		user.LastName = *req.LastName
+	}
+	if req.Email != nil {
+		user.Email = *req.Email
+	}
+
+	var errs model.ValidationErrors
+	errs.Merge("user", user.Validate())
+	if req.Password != nil {
+		switch {
+		case req.CurrentPassword == nil || *req.CurrentPassword == "":
+			errs.Add("current_password", model.CodeRequired, "current_password is required to change the password")
+		case !user.CheckPassword(*req.CurrentPassword):
+			errs.Add("current_password", model.CodeInvalid, "current_password is incorrect")
+		}
+		errs.Merge("password", userModel.ValidatePassword(*req.Password))
+	}
+	if errs.HasErrors() {
+		return nil, errs
+	}
+
+	if req.Password != nil {
+		if err := user.SetPassword(*req.Password); err != nil {
+			return nil, err
+		}
+	}
+
+	user.Version = req.Version + 1
+	user.UpdatedAt = time.Now()
+
+	err = s.inTransaction(ctx, func(ctx context.Context) error {
+		if err := s.userRepository.Update(ctx, user); err != nil {
+			return err
+		}
+
+		return s.publish(ctx, userPort.UserUpdated{
+			UserID:          user.ID,
+			Status:          user.Status,
+			PreviousStatus:  previousStatus,
+			PasswordChanged: req.Password != nil,
+			SessionID:       req.SessionID,
+			Version:         user.Version,
+			OccurredAt:      user.UpdatedAt,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
@@ -0,0 +1,56 @@
+package userService
+
+import (
+	"context"
+	"time"
+
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	"github.com/fbriansyah/go-modular/pkg/database"
+	"github.com/fbriansyah/go-modular/pkg/tracing"
+	userPort "github.com/fbriansyah/go-modular/ports/user"
+)
+
+// UpdateUserStatus changes a user's status and publishes UserUpdated with the
+// previous status, so subscribers can react to deactivation
+func (s *UserService) UpdateUserStatus(ctx context.Context, req *userModel.UpdateUserStatusRequest) (_ *userModel.User, err error) {
+	ctx, span := tracing.Start(ctx, "UserService.UpdateUserStatus")
+	defer span.EndWithError(&err)
+
+	user, err := s.userRepository.GetByID(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// reject stale writes before touching the row
+	if user.Version != req.Version {
+		return nil, database.ErrOptimisticLock
+	}
+	previousStatus := user.Status
+
+	user.Status = req.Status
+	if err := user.Validate(); err != nil {
+		return nil, err
+	}
+
+	user.Version = req.Version + 1
+	user.UpdatedAt = time.Now()
+
+	err = s.inTransaction(ctx, func(ctx context.Context) error {
+		if err := s.userRepository.Update(ctx, user); err != nil {
+			return err
+		}
+
+		return s.publish(ctx, userPort.UserUpdated{
+			UserID:         user.ID,
+			Status:         user.Status,
+			PreviousStatus: previousStatus,
+			Version:        user.Version,
+			OccurredAt:     user.UpdatedAt,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
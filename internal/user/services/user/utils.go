@@ -0,0 +1,45 @@
+package userService
+
+import (
+	"errors"
+
+	"github.com/fbriansyah/go-modular/config"
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+	"github.com/fbriansyah/go-modular/pkg/database"
+)
+
+// buildFilter converts a list query into a repository filter, validating the
+// status and parsing the sort spec against userModel.SortableFields
+func buildFilter(query *userModel.ListUserQuery) (*userModel.UserFilter, error) {
+	if query.Status != "" && !query.Status.IsValid() {
+		return nil, errors.Join(errors.New("invalid user status"), database.ErrInvalidInput)
+	}
+
+	if query.CreatedAfter != nil && query.CreatedBefore != nil && !query.CreatedAfter.Before(*query.CreatedBefore) {
+		return nil, errors.Join(errors.New("created_after must be before created_before"), database.ErrInvalidInput)
+	}
+
+	sort, err := database.ParseSort(query.Sort, userModel.SortableFields)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userModel.UserFilter{
+		FirstName:     query.FirstName,
+		LastName:      query.LastName,
+		Email:         query.Email,
+		Status:        query.Status,
+		CreatedAfter:  query.CreatedAfter,
+		CreatedBefore: query.CreatedBefore,
+		Sort:          sort,
+	}, nil
+}
+
+// pageLimits returns the configured default and maximum page sizes, or the
+// package defaults when the service has no config
+func (s *UserService) pageLimits() (defaultLimit, maxLimit int) {
+	if s.conf == nil {
+		return config.PaginationConfig{}.PageLimits()
+	}
+	return s.conf.Pagination.PageLimits()
+}
@@ -0,0 +1,17 @@
+-- Transactional outbox
+-- Events are written in the same transaction as the change that caused them
+-- and delivered afterwards by the outbox relay
+
+CREATE TABLE outbox (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    event_name VARCHAR(255) NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    published_at TIMESTAMP WITH TIME ZONE
+);
+
+-- The relay only scans messages that still have to be delivered
+CREATE INDEX idx_outbox_pending ON outbox(next_attempt_at, created_at) WHERE published_at IS NULL;
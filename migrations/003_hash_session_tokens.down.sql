@@ -0,0 +1,2 @@
+-- Hashed session keys cannot be turned back into tokens; drop them
+DELETE FROM sessions;
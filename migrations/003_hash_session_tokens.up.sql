@@ -0,0 +1,3 @@
+-- Sessions are now keyed by the SHA-256 of their token; rows holding a
+-- plaintext token can no longer be matched, so drop them
+DELETE FROM sessions;
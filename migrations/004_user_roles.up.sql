@@ -0,0 +1,4 @@
+-- Users are regular users unless granted the admin role, which may list
+-- every user and manage other accounts
+ALTER TABLE users
+    ADD COLUMN role VARCHAR(20) NOT NULL DEFAULT 'user' CHECK (role IN ('user', 'admin'));
@@ -4,9 +4,11 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/fbriansyah/go-modular/config"
+	"github.com/fbriansyah/go-modular/pkg/logging"
 
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq" // PostgreSQL driver
@@ -17,6 +19,15 @@ type DB struct {
 	*sqlx.DB
 	config *config.DatabaseConfig
 	secret *config.DatabaseSecret
+
+	// observer is notified of repository operations, see SetQueryObserver
+	observer QueryObserver
+
+	// logger is used when the context carries no request logger
+	logger *slog.Logger
+
+	// hooks run around every statement, see AddQueryHook
+	hooks []QueryHook
 }
 
 // ConnectionOptions holds database connection configuration
@@ -37,6 +48,25 @@ func DefaultConnectionOptions() ConnectionOptions {
 	}
 }
 
+// ConnectionOptionsFromConfig builds pool options from config, using the
+// defaults for unset values
+func ConnectionOptionsFromConfig(cfg config.PoolConfig) ConnectionOptions {
+	opts := DefaultConnectionOptions()
+	if cfg.MaxOpenConns > 0 {
+		opts.MaxOpenConns = cfg.MaxOpenConns
+	}
+	if cfg.MaxIdleConns > 0 {
+		opts.MaxIdleConns = cfg.MaxIdleConns
+	}
+	if cfg.ConnMaxLifetime > 0 {
+		opts.ConnMaxLifetime = cfg.ConnMaxLifetime
+	}
+	if cfg.ConnMaxIdleTime > 0 {
+		opts.ConnMaxIdleTime = cfg.ConnMaxIdleTime
+	}
+	return opts
+}
+
 // NewConnection creates a new database connection with connection pooling
 func NewConnection(cfg *config.DatabaseConfig, scr *config.DatabaseSecret, opts ConnectionOptions) (*DB, error) {
 	return NewConnectionWithTimeout(cfg, scr, opts, 30*time.Second)
@@ -44,16 +74,9 @@ func NewConnection(cfg *config.DatabaseConfig, scr *config.DatabaseSecret, opts
 
 // NewConnectionWithTimeout creates a new database connection with connection pooling and custom timeout
 func NewConnectionWithTimeout(cfg *config.DatabaseConfig, scr *config.DatabaseSecret, opts ConnectionOptions, timeout time.Duration) (*DB, error) {
-	var dsn string
-
-	// Use DATABASE_URL if provided, otherwise build from individual components
-	if cfg.URL != "" {
-		dsn = cfg.URL
-	} else {
-		dsn = fmt.Sprintf(
-			"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s connect_timeout=%d",
-			cfg.Host, cfg.Port, scr.Username, scr.Password, cfg.Name, cfg.SSLMode, int(timeout.Seconds()),
-		)
+	dsn := BuildDSN(cfg, scr)
+	if cfg.URL == "" {
+		dsn = fmt.Sprintf("%s connect_timeout=%d", dsn, int(timeout.Seconds()))
 	}
 
 	// Create context with timeout for connection attempt
@@ -63,7 +86,7 @@ func NewConnectionWithTimeout(cfg *config.DatabaseConfig, scr *config.DatabaseSe
 	// Open database connection with timeout
 	sqlxDB, err := sqlx.ConnectContext(ctx, "postgres", dsn)
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to database within %v: %w", timeout, err)
+		return nil, fmt.Errorf("failed to connect to database %s within %v: %w", RedactedDSN(cfg, scr), timeout, err)
 	}
 
 	// Configure connection pool
@@ -75,11 +98,31 @@ func NewConnectionWithTimeout(cfg *config.DatabaseConfig, scr *config.DatabaseSe
 	db := &DB{
 		DB:     sqlxDB,
 		config: cfg,
+		secret: scr,
+		hooks:  []QueryHook{TracingHook{}},
 	}
 
 	return db, nil
 }
 
+// SetLogger sets the fallback logger for operations whose context carries
+// no logger. Call it during startup, before the DB is used concurrently.
+func (db *DB) SetLogger(logger *slog.Logger) {
+	db.logger = logger
+}
+
+// Logger returns the logger bound to ctx, falling back to the DB's logger
+// and then slog.Default()
+func (db *DB) Logger(ctx context.Context) *slog.Logger {
+	if logger, ok := logging.Lookup(ctx); ok {
+		return logger
+	}
+	if db != nil && db.logger != nil {
+		return db.logger
+	}
+	return slog.Default()
+}
+
 // HealthCheck performs a health check on the database connection
 func (db *DB) HealthCheck(ctx context.Context) error {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
@@ -105,15 +148,13 @@ func (db *DB) Close() error {
 	return db.DB.Close()
 }
 
-// GetDSN returns the data source name for the database
+// GetDSN returns the data source name for the database, password included.
+// Use RedactedDSN for anything that may be logged.
 func (db *DB) GetDSN() string {
-	if db.config.URL != "" {
-		return db.config.URL
-	}
+	return BuildDSN(db.config, db.secret)
+}
 
-	return fmt.Sprintf(
-		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
-		db.config.Host, db.config.Port, db.secret.Username,
-		db.secret.Password, db.config.Name, db.config.SSLMode,
-	)
+// RedactedDSN returns the data source name with the password masked
+func (db *DB) RedactedDSN() string {
+	return RedactedDSN(db.config, db.secret)
 }
@@ -0,0 +1,56 @@
+package database
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// ErrInvalidCursor is returned when a pagination cursor cannot be decoded
+var ErrInvalidCursor = fmt.Errorf("%w: malformed cursor", ErrInvalidInput)
+
+// cursorPayload is the JSON document hidden inside an opaque cursor
+type cursorPayload[K any] struct {
+	Key K `json:"k"`
+}
+
+// EncodeCursor turns a keyset position into an opaque, URL-safe cursor
+func EncodeCursor[K any](key K) (string, error) {
+	b, err := json.Marshal(cursorPayload[K]{Key: key})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// DecodeCursor reverses EncodeCursor
+func DecodeCursor[K any](cursor string) (K, error) {
+	var payload cursorPayload[K]
+
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return payload.Key, ErrInvalidCursor
+	}
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return payload.Key, ErrInvalidCursor
+	}
+
+	return payload.Key, nil
+}
+
+// KeysetPage trims a result that was fetched with limit+1 rows back to limit
+// and, when the extra row proves another page exists, returns the cursor for
+// the last row kept. An empty cursor means this is the last page.
+func KeysetPage[T any, K any](items []*T, limit int, key func(*T) K) ([]*T, string, error) {
+	if limit <= 0 || len(items) <= limit {
+		return items, "", nil
+	}
+
+	items = items[:limit]
+	next, err := EncodeCursor(key(items[len(items)-1]))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return items, next, nil
+}
@@ -0,0 +1,100 @@
+package database
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	type position struct {
+		CreatedAt string `json:"c"`
+		ID        string `json:"i"`
+	}
+
+	cursor, err := EncodeCursor("0190b6a4-7c1e-7000-8000-000000000001")
+	if err != nil {
+		t.Fatalf("EncodeCursor: %v", err)
+	}
+	if got, err := DecodeCursor[string](cursor); err != nil || got != "0190b6a4-7c1e-7000-8000-000000000001" {
+		t.Fatalf("DecodeCursor = %q, %v", got, err)
+	}
+
+	want := position{CreatedAt: "2024-01-02T03:04:05Z", ID: "42"}
+	cursor, err = EncodeCursor(want)
+	if err != nil {
+		t.Fatalf("EncodeCursor: %v", err)
+	}
+	if got, err := DecodeCursor[position](cursor); err != nil || got != want {
+		t.Fatalf("DecodeCursor = %+v, %v, want %+v", got, err, want)
+	}
+}
+
+func TestDecodeCursorRejectsMalformedInput(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{"invalid base64", "not*base64!"},
+		{"padded base64", base64.URLEncoding.EncodeToString([]byte(`{"k":"ab"}`))},
+		{"invalid JSON", base64.RawURLEncoding.EncodeToString([]byte(`{"k":`))},
+		{"wrong key type", base64.RawURLEncoding.EncodeToString([]byte(`{"k":42}`))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeCursor[string](tt.cursor)
+			if !errors.Is(err, ErrInvalidCursor) || !errors.Is(err, ErrInvalidInput) {
+				t.Fatalf("DecodeCursor(%q): got %v, want ErrInvalidCursor wrapping ErrInvalidInput", tt.cursor, err)
+			}
+			if !IsInvalidInputError(err) {
+				t.Fatalf("DecodeCursor(%q): error is not reported as invalid input", tt.cursor)
+			}
+		})
+	}
+}
+
+func TestKeysetPage(t *testing.T) {
+	type row struct{ ID int }
+	rows := func(n int) []*row {
+		items := make([]*row, n)
+		for i := range items {
+			items[i] = &row{ID: i + 1}
+		}
+		return items
+	}
+	key := func(r *row) int { return r.ID }
+
+	tests := []struct {
+		name     string
+		fetched  int
+		limit    int
+		wantLen  int
+		wantNext bool
+	}{
+		{"extra row trimmed", 4, 3, 3, true},
+		{"exactly limit", 3, 3, 3, false},
+		{"short page", 2, 3, 2, false},
+		{"empty", 0, 3, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, next, err := KeysetPage(rows(tt.fetched), tt.limit, key)
+			if err != nil {
+				t.Fatalf("KeysetPage: %v", err)
+			}
+			if len(items) != tt.wantLen {
+				t.Fatalf("got %d items, want %d", len(items), tt.wantLen)
+			}
+			if (next != "") != tt.wantNext {
+				t.Fatalf("got next cursor %q, want one: %v", next, tt.wantNext)
+			}
+			if next == "" {
+				return
+			}
+			// the cursor points at the last row kept, not the extra row
+			if id, err := DecodeCursor[int](next); err != nil || id != tt.limit {
+				t.Fatalf("next cursor decodes to %d, %v, want %d", id, err, tt.limit)
+			}
+		})
+	}
+}
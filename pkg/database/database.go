@@ -3,7 +3,7 @@ package database
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"time"
 
 	"github.com/fbriansyah/go-modular/config"
@@ -15,12 +15,37 @@ type Manager struct {
 	HealthChecker   *HealthChecker
 	MigrationRunner *MigrationRunner
 	config          *config.DatabaseConfig
+	logger          *slog.Logger
+	hooks           []QueryHook
+}
+
+// ManagerOption configures a Manager
+type ManagerOption func(*Manager)
+
+// WithLogger sets the logger used by the manager and its DB; slog.Default() otherwise
+func WithLogger(logger *slog.Logger) ManagerOption {
+	return func(m *Manager) {
+		m.logger = logger
+	}
+}
+
+// WithQueryHooks adds hooks run around every statement, nested inside the
+// built-in tracing and logging hooks
+func WithQueryHooks(hooks ...QueryHook) ManagerOption {
+	return func(m *Manager) {
+		m.hooks = append(m.hooks, hooks...)
+	}
 }
 
 // NewManager creates a new database manager with all components
-func NewManager(cfg *config.DatabaseConfig, scr *config.DatabaseSecret, migrationsPath string) (*Manager, error) {
+func NewManager(cfg *config.DatabaseConfig, scr *config.DatabaseSecret, migrationsPath string, opts ...ManagerOption) (*Manager, error) {
+	m := &Manager{config: cfg, logger: slog.Default()}
+	for _, opt := range opts {
+		opt(m)
+	}
+
 	// Create database connection
-	db, err := NewConnection(cfg, scr, DefaultConnectionOptions())
+	db, err := NewConnectionWithTimeout(cfg, scr, ConnectionOptionsFromConfig(cfg.Pool), cfg.Pool.GetConnectTimeout())
 	if err != nil {
 		return nil, fmt.Errorf("failed to create database connection: %w", err)
 	}
@@ -44,17 +69,21 @@ func NewManager(cfg *config.DatabaseConfig, scr *config.DatabaseSecret, migratio
 		return nil, fmt.Errorf("failed to create migration runner: %w", err)
 	}
 
-	return &Manager{
-		DB:              db,
-		HealthChecker:   healthChecker,
-		MigrationRunner: migrationRunner,
-		config:          cfg,
-	}, nil
+	db.SetLogger(m.logger)
+	db.AddQueryHook(NewLoggingHook(db, cfg.SlowQueryThreshold))
+	for _, hook := range m.hooks {
+		db.AddQueryHook(hook)
+	}
+	m.DB = db
+	m.HealthChecker = healthChecker
+	m.MigrationRunner = migrationRunner
+
+	return m, nil
 }
 
 // Initialize sets up the database with migrations and validation
 func (m *Manager) Initialize(ctx context.Context, runMigrations bool) error {
-	log.Println("Initializing database...")
+	m.logger.Info("Manager", "message", "initializing database")
 
 	// Wait for database to be available
 	if err := m.HealthChecker.WaitForConnection(ctx, 30, 2*time.Second); err != nil {
@@ -63,7 +92,7 @@ func (m *Manager) Initialize(ctx context.Context, runMigrations bool) error {
 
 	// Run migrations if requested
 	if runMigrations {
-		log.Println("Running database migrations...")
+		m.logger.Info("Manager", "message", "running database migrations")
 		if err := m.MigrationRunner.Up(); err != nil {
 			return fmt.Errorf("failed to run migrations: %w", err)
 		}
@@ -71,9 +100,9 @@ func (m *Manager) Initialize(ctx context.Context, runMigrations bool) error {
 		// Log current migration version
 		version, dirty, err := m.MigrationRunner.Version()
 		if err != nil {
-			log.Printf("Warning: could not get migration version: %v", err)
+			m.logger.Warn("Manager", "message", "could not get migration version", "error", err)
 		} else {
-			log.Printf("Database migration version: %d (dirty: %t)", version, dirty)
+			m.logger.Info("Manager", "message", "database migrated", "version", version, "dirty", dirty)
 		}
 	}
 
@@ -83,26 +112,26 @@ func (m *Manager) Initialize(ctx context.Context, runMigrations bool) error {
 		return fmt.Errorf("database health check failed: %s", status.Message)
 	}
 
-	log.Printf("Database initialized successfully (latency: %v)", status.Latency)
+	m.logger.Info("Manager", "message", "database initialized", "latency", status.Latency)
 	return nil
 }
 
 // Close gracefully closes all database connections
 func (m *Manager) Close() error {
-	log.Println("Closing database connections...")
+	m.logger.Info("Manager", "message", "closing database connections")
 
 	var lastErr error
 
 	if m.MigrationRunner != nil {
 		if err := m.MigrationRunner.Close(); err != nil {
-			log.Printf("Error closing migration runner: %v", err)
+			m.logger.Error("Manager", "message", "failed to close migration runner", "error", err)
 			lastErr = err
 		}
 	}
 
 	if m.DB != nil {
 		if err := m.DB.Close(); err != nil {
-			log.Printf("Error closing database connection: %v", err)
+			m.logger.Error("Manager", "message", "failed to close database connection", "error", err)
 			lastErr = err
 		}
 	}
@@ -0,0 +1,65 @@
+// Package dbtest connects tests to a PostgreSQL test database configured
+// through TEST_DB_* environment variables.
+package dbtest
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/fbriansyah/go-modular/config"
+	"github.com/fbriansyah/go-modular/pkg/database"
+)
+
+// Open connects to the test database and applies the migrations in
+// migrationsPath. The test is skipped when TEST_DB_HOST is unset or the
+// database is unreachable; the connection is closed when the test ends.
+func Open(t testing.TB, migrationsPath string) *database.DB {
+	t.Helper()
+
+	host := os.Getenv("TEST_DB_HOST")
+	if host == "" {
+		t.Skip("TEST_DB_HOST not set, skipping database test")
+	}
+
+	cfg := &config.DatabaseConfig{
+		Host:    host,
+		Port:    getenv("TEST_DB_PORT", "5432"),
+		Name:    getenv("TEST_DB_NAME", "test_db"),
+		SSLMode: getenv("TEST_DB_SSLMODE", "disable"),
+	}
+	scr := &config.DatabaseSecret{
+		Username: getenv("TEST_DB_USER", "postgres"),
+		Password: config.SecretString(getenv("TEST_DB_PASSWORD", "postgres")),
+	}
+
+	timeout := 5 * time.Second
+	if d, err := time.ParseDuration(os.Getenv("TEST_DB_TIMEOUT")); err == nil {
+		timeout = d
+	}
+
+	db, err := database.NewConnectionWithTimeout(cfg, scr, database.DefaultConnectionOptions(), timeout)
+	if err != nil {
+		t.Skipf("test database unavailable: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	runner, err := database.NewMigrationRunner(cfg, scr, migrationsPath)
+	if err != nil {
+		t.Fatalf("create migration runner: %v", err)
+	}
+	defer runner.Close()
+
+	if err := runner.Up(); err != nil {
+		t.Fatalf("apply migrations: %v", err)
+	}
+
+	return db
+}
+
+func getenv(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
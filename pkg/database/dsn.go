@@ -0,0 +1,77 @@
+package database
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/fbriansyah/go-modular/config"
+)
+
+// redactedPassword replaces the password in DSNs meant for logs
+const redactedPassword = "****"
+
+// keyValuePassword matches the password of a key/value DSN, quoted or not
+var keyValuePassword = regexp.MustCompile(`(?i)(\bpassword\s*=\s*)('(?:[^'\\]|\\.)*'|\S*)`)
+
+// BuildDSN returns the connection string for cfg: database.url when set,
+// otherwise a key/value DSN built from the individual settings
+func BuildDSN(cfg *config.DatabaseConfig, scr *config.DatabaseSecret) string {
+	if cfg.URL != "" {
+		return cfg.URL
+	}
+	return keyValueDSN(cfg, scr, scr.Password.Reveal())
+}
+
+// RedactedDSN returns BuildDSN with the password masked, safe for logs and errors
+func RedactedDSN(cfg *config.DatabaseConfig, scr *config.DatabaseSecret) string {
+	if cfg.URL != "" {
+		return redactURL(cfg.URL)
+	}
+
+	password := ""
+	if !scr.Password.IsEmpty() {
+		password = redactedPassword
+	}
+	return keyValueDSN(cfg, scr, password)
+}
+
+func keyValueDSN(cfg *config.DatabaseConfig, scr *config.DatabaseSecret, password string) string {
+	return fmt.Sprintf(
+		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+		dsnValue(cfg.Host), dsnValue(cfg.Port), dsnValue(scr.Username),
+		dsnValue(password), dsnValue(cfg.Name), dsnValue(cfg.SSLMode),
+	)
+}
+
+// dsnValue quotes a key/value DSN value when it is empty or contains spaces, quotes or backslashes
+func dsnValue(v string) string {
+	if v != "" && !strings.ContainsAny(v, ` '\`) {
+		return v
+	}
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(v) + "'"
+}
+
+// redactURL masks the password of a postgres:// URL or a key/value DSN, or
+// the whole value when it cannot be parsed
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return redactedPassword
+	}
+	if u.Scheme == "" {
+		return keyValuePassword.ReplaceAllString(raw, "${1}"+redactedPassword)
+	}
+
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), redactedPassword)
+	}
+	if q := u.Query(); q.Has("password") {
+		q.Set("password", redactedPassword)
+		u.RawQuery = q.Encode()
+	}
+
+	// keep the mask readable instead of percent-encoded
+	return strings.ReplaceAll(u.String(), url.QueryEscape(redactedPassword), redactedPassword)
+}
@@ -0,0 +1,45 @@
+package database
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fbriansyah/go-modular/config"
+)
+
+func TestRedactedDSNNeverContainsPassword(t *testing.T) {
+	const password = "s3cr3t-P@ss"
+
+	tests := []struct {
+		name string
+		cfg  config.DatabaseConfig
+		scr  config.DatabaseSecret
+	}{
+		{
+			name: "key/value settings",
+			cfg:  config.DatabaseConfig{Host: "db", Port: "5432", Name: "app", SSLMode: "disable"},
+			scr:  config.DatabaseSecret{Username: "app", Password: password},
+		},
+		{
+			name: "quoted key/value password",
+			cfg:  config.DatabaseConfig{Host: "db", Port: "5432", Name: "app", SSLMode: "disable"},
+			scr:  config.DatabaseSecret{Username: "app", Password: "it's " + password},
+		},
+		{name: "url userinfo", cfg: config.DatabaseConfig{URL: "postgres://app:" + password + "@db:5432/app?sslmode=disable"}},
+		{name: "url query", cfg: config.DatabaseConfig{URL: "postgres://db/app?user=app&password=" + password}},
+		{name: "key/value url", cfg: config.DatabaseConfig{URL: "host=db user=app password=" + password + " dbname=app"}},
+		{name: "quoted key/value url", cfg: config.DatabaseConfig{URL: "host=db password='" + password + " x' dbname=app"}},
+		{name: "unparseable url", cfg: config.DatabaseConfig{URL: "postgres://app:" + password + "@db:port/app"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RedactedDSN(&tt.cfg, &tt.scr)
+			if strings.Contains(got, password) {
+				t.Fatalf("RedactedDSN leaks the password: %s", got)
+			}
+			if !strings.Contains(got, redactedPassword) {
+				t.Fatalf("RedactedDSN does not mark the password as redacted: %s", got)
+			}
+		})
+	}
+}
@@ -146,6 +146,11 @@ func IsTransactionError(err error) bool {
 	return errors.Is(err, ErrTransactionFailed)
 }
 
+// IsInvalidInputError checks if an error is an invalid input error
+func IsInvalidInputError(err error) bool {
+	return errors.Is(err, ErrInvalidInput)
+}
+
 // IsValidationError checks if an error is a validation error
 func IsValidationError(err error) bool {
 	var validationErr *ValidationError
@@ -0,0 +1,109 @@
+package database
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/fbriansyah/go-modular/pkg/tracing"
+)
+
+// QueryEvent describes one SQL statement passing through DB or Tx
+type QueryEvent struct {
+	// Method is the DB method used: Get, Select, Exec or NamedExec
+	Method string
+	Query  string
+	// Args are the positional arguments, or the single named argument for
+	// NamedExec. Use RedactArgs before logging them.
+	Args []any
+	// InTx reports whether the statement ran inside a transaction
+	InTx  bool
+	Start time.Time
+
+	// set before AfterQuery is called
+	Duration time.Duration
+	// Rows is the number of rows affected (Exec, NamedExec) or returned
+	// (Get, Select); -1 when unknown
+	Rows int64
+	Err  error
+}
+
+// Operation returns the leading SQL keyword of the statement, e.g. SELECT
+func (e *QueryEvent) Operation() string {
+	fields := strings.Fields(e.Query)
+	if len(fields) == 0 {
+		return "UNKNOWN"
+	}
+	return strings.ToUpper(fields[0])
+}
+
+// QueryHook intercepts every statement run through DB or a Tx obtained
+// from GetTxFromContext. BeforeQuery may return a derived context, which is
+// used for the statement and passed to AfterQuery.
+type QueryHook interface {
+	BeforeQuery(ctx context.Context, event *QueryEvent) context.Context
+	AfterQuery(ctx context.Context, event *QueryEvent)
+}
+
+// AddQueryHook appends hook to the hooks run around every statement.
+// Call it during startup, before the DB is used concurrently.
+func (db *DB) AddQueryHook(hook QueryHook) {
+	db.hooks = append(db.hooks, hook)
+}
+
+// runQuery runs fn between the hooks. BeforeQuery hooks run in the order
+// they were added and AfterQuery hooks in reverse, so hooks nest. db may be
+// nil, as for a Tx from WithTransaction, in which case fn runs without hooks.
+func (db *DB) runQuery(ctx context.Context, event *QueryEvent, fn func(ctx context.Context) (int64, error)) error {
+	var hooks []QueryHook
+	if db != nil {
+		hooks = db.hooks
+	}
+
+	event.Start = time.Now()
+	contexts := make([]context.Context, len(hooks))
+	for i, hook := range hooks {
+		ctx = hook.BeforeQuery(ctx, event)
+		contexts[i] = ctx
+	}
+
+	event.Rows, event.Err = fn(ctx)
+	event.Duration = time.Since(event.Start)
+
+	for i := len(hooks) - 1; i >= 0; i-- {
+		hooks[i].AfterQuery(contexts[i], event)
+	}
+
+	return event.Err
+}
+
+// TracingHook starts a client span for every statement. Only the statement
+// text is recorded, never its arguments. New connections install it first.
+type TracingHook struct{}
+
+// BeforeQuery implements QueryHook
+func (TracingHook) BeforeQuery(ctx context.Context, event *QueryEvent) context.Context {
+	operation := event.Operation()
+	ctx, _ = tracing.Start(ctx, "sql."+operation,
+		tracing.WithSpanKind(tracing.SpanKindClient),
+		tracing.WithAttributes(
+			"db.system", "postgresql",
+			"db.operation", operation,
+			"db.statement", strings.Join(strings.Fields(event.Query), " "),
+		),
+	)
+	return ctx
+}
+
+// AfterQuery implements QueryHook
+func (TracingHook) AfterQuery(ctx context.Context, event *QueryEvent) {
+	span := tracing.SpanFromContext(ctx)
+	if span == nil {
+		return
+	}
+	if event.Rows >= 0 {
+		span.SetAttribute("db.rows", event.Rows)
+	}
+	span.RecordError(event.Err)
+	span.End()
+}
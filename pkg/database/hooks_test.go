@@ -0,0 +1,72 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// orderHook appends its name to calls when each of its methods runs
+type orderHook struct {
+	name  string
+	calls *[]string
+}
+
+type orderKey struct{ name string }
+
+func (h orderHook) BeforeQuery(ctx context.Context, _ *QueryEvent) context.Context {
+	*h.calls = append(*h.calls, "before "+h.name)
+	return context.WithValue(ctx, orderKey{h.name}, true)
+}
+
+func (h orderHook) AfterQuery(ctx context.Context, _ *QueryEvent) {
+	if ctx.Value(orderKey{h.name}) == nil {
+		*h.calls = append(*h.calls, "after "+h.name+" without its context")
+		return
+	}
+	*h.calls = append(*h.calls, "after "+h.name)
+}
+
+func TestRunQueryNestsHooks(t *testing.T) {
+	var calls []string
+	db := &DB{}
+	db.AddQueryHook(orderHook{"outer", &calls})
+	db.AddQueryHook(orderHook{"inner", &calls})
+
+	errQuery := errors.New("query failed")
+	event := &QueryEvent{Query: "SELECT 1"}
+	err := db.runQuery(context.Background(), event, func(ctx context.Context) (int64, error) {
+		if ctx.Value(orderKey{"inner"}) == nil {
+			t.Error("statement does not run with the context of the last hook")
+		}
+		calls = append(calls, "query")
+		return -1, errQuery
+	})
+
+	if !errors.Is(err, errQuery) || !errors.Is(event.Err, errQuery) {
+		t.Fatalf("runQuery returned %v, event error %v, want %v", err, event.Err, errQuery)
+	}
+	want := []string{"before outer", "before inner", "query", "after inner", "after outer"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Fatalf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestQueryEventOperation(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"SELECT 1", "SELECT"},
+		{"\n\t  update users SET x = 1", "UPDATE"},
+		{"", "UNKNOWN"},
+		{"   ", "UNKNOWN"},
+	}
+	for _, tt := range tests {
+		event := &QueryEvent{Query: tt.query}
+		if got := event.Operation(); got != tt.want {
+			t.Errorf("Operation(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
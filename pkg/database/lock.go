@@ -0,0 +1,38 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+)
+
+// ErrLockNotAcquired is returned when an advisory lock is held by another session
+var ErrLockNotAcquired = errors.New("advisory lock not acquired")
+
+// AdvisoryLockKey derives a stable advisory lock key from a human-readable name
+func AdvisoryLockKey(name string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	return int64(h.Sum64())
+}
+
+// WithAdvisoryLock runs fn inside a transaction holding a transaction-scoped
+// Postgres advisory lock (pg_try_advisory_xact_lock). The lock is released when
+// the transaction ends. If another session holds the lock, fn is not executed
+// and ErrLockNotAcquired is returned.
+func WithAdvisoryLock(ctx context.Context, db *DB, key int64, fn func(ctx context.Context) error) error {
+	return ExecuteInTransaction(ctx, db, func(txCtx context.Context) error {
+		tx := GetTxFromContext(txCtx)
+
+		var acquired bool
+		if err := tx.GetContext(txCtx, &acquired, "SELECT pg_try_advisory_xact_lock($1)", key); err != nil {
+			return fmt.Errorf("failed to acquire advisory lock: %w", err)
+		}
+		if !acquired {
+			return ErrLockNotAcquired
+		}
+
+		return fn(txCtx)
+	})
+}
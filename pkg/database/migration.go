@@ -20,17 +20,7 @@ type MigrationRunner struct {
 
 // NewMigrationRunner creates a new migration runner
 func NewMigrationRunner(cfg *config.DatabaseConfig, scr *config.DatabaseSecret, migrationsPath string) (*MigrationRunner, error) {
-	var dsn string
-
-	// Use DATABASE_URL if provided, otherwise build from individual components
-	if cfg.URL != "" {
-		dsn = cfg.URL
-	} else {
-		dsn = fmt.Sprintf(
-			"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
-			cfg.Host, cfg.Port, scr.Username, scr.Password, cfg.Name, cfg.SSLMode,
-		)
-	}
+	dsn := BuildDSN(cfg, scr)
 
 	// Open database connection for migrations
 	db, err := sql.Open("postgres", dsn)
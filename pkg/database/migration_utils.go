@@ -1,6 +1,7 @@
 package database
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -20,6 +21,10 @@ type MigrationInfo struct {
 	Description string
 }
 
+// ErrNoMigrationRunner is returned when a database operation is requested from
+// a MigrationManager created without a database connection
+var ErrNoMigrationRunner = errors.New("migration manager has no database connection")
+
 // MigrationManager provides utilities for managing migrations
 type MigrationManager struct {
 	migrationsPath string
@@ -39,6 +44,15 @@ func NewMigrationManager(cfg *config.DatabaseConfig, scr *config.DatabaseSecret,
 	}, nil
 }
 
+// NewFileMigrationManager creates a migration manager that only works with
+// migration files (CreateMigration, ListMigrations, ValidateMigrations) and
+// does not need a database connection
+func NewFileMigrationManager(migrationsPath string) *MigrationManager {
+	return &MigrationManager{
+		migrationsPath: migrationsPath,
+	}
+}
+
 // Close closes the migration manager
 func (mm *MigrationManager) Close() error {
 	if mm.runner != nil {
@@ -200,11 +214,17 @@ func (mm *MigrationManager) ListMigrations() ([]MigrationInfo, error) {
 
 // GetCurrentVersion returns the current migration version
 func (mm *MigrationManager) GetCurrentVersion() (uint, bool, error) {
+	if mm.runner == nil {
+		return 0, false, ErrNoMigrationRunner
+	}
 	return mm.runner.Version()
 }
 
 // GetStatus returns the migration status
 func (mm *MigrationManager) GetStatus() (*MigrationStatus, error) {
+	if mm.runner == nil {
+		return nil, ErrNoMigrationRunner
+	}
 	currentVersion, dirty, err := mm.runner.Version()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get current version: %w", err)
@@ -245,21 +265,33 @@ func (mm *MigrationManager) GetStatus() (*MigrationStatus, error) {
 
 // MigrateUp runs migrations up
 func (mm *MigrationManager) MigrateUp() error {
+	if mm.runner == nil {
+		return ErrNoMigrationRunner
+	}
 	return mm.runner.Up()
 }
 
 // MigrateDown runs migrations down
 func (mm *MigrationManager) MigrateDown() error {
+	if mm.runner == nil {
+		return ErrNoMigrationRunner
+	}
 	return mm.runner.Down()
 }
 
 // MigrateSteps runs n migration steps
 func (mm *MigrationManager) MigrateSteps(n int) error {
+	if mm.runner == nil {
+		return ErrNoMigrationRunner
+	}
 	return mm.runner.Steps(n)
 }
 
 // MigrateTo migrates to a specific version
 func (mm *MigrationManager) MigrateTo(targetVersion uint) error {
+	if mm.runner == nil {
+		return ErrNoMigrationRunner
+	}
 	currentVersion, _, err := mm.runner.Version()
 	if err != nil {
 		return fmt.Errorf("failed to get current version: %w", err)
@@ -282,6 +314,9 @@ func (mm *MigrationManager) MigrateTo(targetVersion uint) error {
 
 // Force forces the migration version
 func (mm *MigrationManager) Force(version int) error {
+	if mm.runner == nil {
+		return ErrNoMigrationRunner
+	}
 	return mm.runner.Force(version)
 }
 
@@ -0,0 +1,65 @@
+package database
+
+import (
+	"errors"
+	"time"
+)
+
+// QueryObserver receives the duration and outcome of every repository operation
+type QueryObserver interface {
+	ObserveQuery(table, op string, duration time.Duration, err error)
+}
+
+// SetQueryObserver installs the observer notified by repositories built on
+// this DB. Call it during startup, before the DB is used concurrently.
+func (db *DB) SetQueryObserver(observer QueryObserver) {
+	db.observer = observer
+}
+
+// Observe reports a repository operation to the DB's query observer. Call
+// it deferred with a named error result so the final, mapped error is seen:
+//
+//	func (r *UserRepository) GetByID(ctx context.Context, id string) (_ *User, err error) {
+//		defer r.Observe("GetByID", time.Now(), &err)
+func (r *BaseRepository[T, ID]) Observe(op string, start time.Time, errp *error) {
+	if r.db == nil || r.db.observer == nil {
+		return
+	}
+
+	var err error
+	if errp != nil {
+		err = *errp
+	}
+
+	table := r.tableName
+	var dbErr *DatabaseError
+	if errors.As(err, &dbErr) {
+		table, op = dbErr.Table, dbErr.Op
+	}
+
+	r.db.observer.ObserveQuery(table, op, time.Since(start), err)
+}
+
+// ErrorKind classifies err into a short, bounded label for metrics
+func ErrorKind(err error) string {
+	switch {
+	case err == nil:
+		return ""
+	case IsNotFoundError(err):
+		return "not_found"
+	case IsDuplicateKeyError(err):
+		return "duplicate_key"
+	case IsForeignKeyViolationError(err):
+		return "foreign_key"
+	case IsOptimisticLockError(err):
+		return "optimistic_lock"
+	case IsInvalidInputError(err):
+		return "invalid_input"
+	case errors.Is(err, ErrLockNotAcquired):
+		return "lock_not_acquired"
+	case IsConnectionError(err):
+		return "connection"
+	default:
+		return "other"
+	}
+}
@@ -0,0 +1,206 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const outboxTable = "outbox"
+
+// ErrUnknownEvent is returned by sinks that cannot decode an outbox message
+var ErrUnknownEvent = errors.New("unknown outbox event")
+
+// OutboxMessage is an event stored in the outbox table
+type OutboxMessage struct {
+	ID            string          `db:"id"`
+	EventName     string          `db:"event_name"`
+	Payload       json.RawMessage `db:"payload"`
+	CreatedAt     time.Time       `db:"created_at"`
+	Attempts      int             `db:"attempts"`
+	NextAttemptAt time.Time       `db:"next_attempt_at"`
+}
+
+// OutboxWriter stores events in the outbox table
+type OutboxWriter struct {
+	db *DB
+}
+
+// NewOutboxWriter creates an outbox writer
+func NewOutboxWriter(db *DB) *OutboxWriter {
+	return &OutboxWriter{db: db}
+}
+
+// Write stores an event. When ctx carries a transaction (see
+// ExecuteInTransaction) the event is written in that transaction, so it is
+// persisted if and only if the surrounding change commits.
+func (w *OutboxWriter) Write(ctx context.Context, eventName string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s payload: %w", eventName, err)
+	}
+
+	query := `INSERT INTO outbox (event_name, payload) VALUES ($1, $2)`
+
+	tx := GetTxFromContext(ctx)
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query, eventName, data)
+	} else {
+		_, err = w.db.ExecContext(ctx, query, eventName, data)
+	}
+
+	if err != nil {
+		return NewDatabaseError("OutboxWrite", outboxTable, err)
+	}
+
+	return nil
+}
+
+// OutboxSink delivers outbox messages to their destination
+type OutboxSink interface {
+	Deliver(ctx context.Context, msg *OutboxMessage) error
+}
+
+// OutboxSinkFunc adapts a function to an OutboxSink
+type OutboxSinkFunc func(ctx context.Context, msg *OutboxMessage) error
+
+// Deliver calls f
+func (f OutboxSinkFunc) Deliver(ctx context.Context, msg *OutboxMessage) error {
+	return f(ctx, msg)
+}
+
+// OutboxRelayOptions tunes how the relay claims and retries messages
+type OutboxRelayOptions struct {
+	// BatchSize is the maximum number of messages claimed per poll
+	BatchSize int
+	// MaxAttempts stops retrying a message after this many failures; 0 retries forever
+	MaxAttempts int
+	// MinBackoff is the delay after the first failure; it doubles on every further failure
+	MinBackoff time.Duration
+	// MaxBackoff caps the delay between attempts
+	MaxBackoff time.Duration
+}
+
+// DefaultOutboxRelayOptions returns sensible relay defaults
+func DefaultOutboxRelayOptions() OutboxRelayOptions {
+	return OutboxRelayOptions{
+		BatchSize:   100,
+		MaxAttempts: 10,
+		MinBackoff:  time.Second,
+		MaxBackoff:  5 * time.Minute,
+	}
+}
+
+// OutboxRelay delivers pending outbox messages to a sink.
+//
+// Messages are claimed with FOR UPDATE SKIP LOCKED, so several replicas can
+// relay concurrently without delivering the same message at the same time.
+// Delivery is at-least-once: a message is retried with exponential backoff
+// until the sink accepts it, so sinks must be idempotent.
+type OutboxRelay struct {
+	db   *DB
+	sink OutboxSink
+	opts OutboxRelayOptions
+}
+
+// NewOutboxRelay creates a relay delivering to sink
+func NewOutboxRelay(db *DB, sink OutboxSink, opts OutboxRelayOptions) *OutboxRelay {
+	defaults := DefaultOutboxRelayOptions()
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaults.BatchSize
+	}
+	if opts.MinBackoff <= 0 {
+		opts.MinBackoff = defaults.MinBackoff
+	}
+	if opts.MaxBackoff < opts.MinBackoff {
+		opts.MaxBackoff = opts.MinBackoff
+	}
+
+	return &OutboxRelay{db: db, sink: sink, opts: opts}
+}
+
+// ProcessBatch claims one batch of due messages, delivers them in creation
+// order and records the outcome. It returns the number of messages delivered.
+func (r *OutboxRelay) ProcessBatch(ctx context.Context) (int, error) {
+	delivered := 0
+
+	err := ExecuteInTransaction(ctx, r.db, func(txCtx context.Context) error {
+		tx := GetTxFromContext(txCtx)
+
+		query := `
+			SELECT id, event_name, payload, created_at, attempts, next_attempt_at
+			FROM outbox
+			WHERE published_at IS NULL
+			  AND next_attempt_at <= NOW()
+			  AND ($1 = 0 OR attempts < $1)
+			ORDER BY created_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED`
+
+		var messages []*OutboxMessage
+		if err := tx.SelectContext(txCtx, &messages, query, r.opts.MaxAttempts, r.opts.BatchSize); err != nil {
+			return NewDatabaseError("OutboxClaim", outboxTable, err)
+		}
+
+		for _, msg := range messages {
+			if deliverErr := r.deliver(txCtx, msg); deliverErr != nil {
+				attempts := msg.Attempts + 1
+				_, err := tx.ExecContext(txCtx,
+					`UPDATE outbox SET attempts = $2, last_error = $3, next_attempt_at = $4 WHERE id = $1`,
+					msg.ID, attempts, deliverErr.Error(), time.Now().Add(r.Backoff(attempts)),
+				)
+				if err != nil {
+					return NewDatabaseError("OutboxRetry", outboxTable, err)
+				}
+				continue
+			}
+
+			_, err := tx.ExecContext(txCtx,
+				`UPDATE outbox SET attempts = attempts + 1, last_error = NULL, published_at = NOW() WHERE id = $1`,
+				msg.ID,
+			)
+			if err != nil {
+				return NewDatabaseError("OutboxAck", outboxTable, err)
+			}
+			delivered++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return delivered, nil
+}
+
+// Backoff returns the delay before the next attempt after the given number of failures
+func (r *OutboxRelay) Backoff(attempts int) time.Duration {
+	delay := r.opts.MinBackoff
+	for i := 1; i < attempts && delay < r.opts.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, r.opts.MaxBackoff)
+}
+
+// deliver hands msg to the sink, converting a panic into an error
+func (r *OutboxRelay) deliver(ctx context.Context, msg *OutboxMessage) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("panic: %v", rec)
+		}
+	}()
+
+	// handlers must not write through the relay's transaction
+	if err := r.sink.Deliver(context.WithValue(ctx, TxKey{}, nil), msg); err != nil {
+		return err
+	}
+	return nil
+}
+
+// BatchSize returns the maximum number of messages claimed per poll
+func (r *OutboxRelay) BatchSize() int {
+	return r.opts.BatchSize
+}
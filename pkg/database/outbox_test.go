@@ -0,0 +1,194 @@
+package database_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/fbriansyah/go-modular/pkg/database"
+	"github.com/fbriansyah/go-modular/pkg/database/dbtest"
+)
+
+// openOutbox connects to the test database with an empty outbox table
+func openOutbox(t *testing.T) *database.DB {
+	t.Helper()
+
+	db := dbtest.Open(t, "../../migrations")
+	if _, err := db.ExecContext(context.Background(), `DELETE FROM outbox`); err != nil {
+		t.Fatalf("clear outbox: %v", err)
+	}
+	t.Cleanup(func() { db.ExecContext(context.Background(), `DELETE FROM outbox`) })
+	return db
+}
+
+// outboxRow is the relay bookkeeping of a single outbox message
+type outboxRow struct {
+	Attempts      int        `db:"attempts"`
+	NextAttemptAt time.Time  `db:"next_attempt_at"`
+	PublishedAt   *time.Time `db:"published_at"`
+}
+
+func getOutboxRow(t *testing.T, db *database.DB, eventName string) outboxRow {
+	t.Helper()
+
+	var row outboxRow
+	err := db.GetContext(context.Background(), &row,
+		`SELECT attempts, next_attempt_at, published_at FROM outbox WHERE event_name = $1`, eventName)
+	if err != nil {
+		t.Fatalf("get outbox row %s: %v", eventName, err)
+	}
+	return row
+}
+
+func TestOutboxWriterJoinsCallerTransaction(t *testing.T) {
+	db := openOutbox(t)
+	ctx := context.Background()
+	writer := database.NewOutboxWriter(db)
+
+	errRollback := errors.New("rollback")
+	err := database.ExecuteInTransaction(ctx, db, func(ctx context.Context) error {
+		if err := writer.Write(ctx, "test.rolled_back", map[string]string{"id": "1"}); err != nil {
+			return err
+		}
+		return errRollback
+	})
+	if !errors.Is(err, errRollback) {
+		t.Fatalf("ExecuteInTransaction: got %v, want the rollback error", err)
+	}
+
+	err = database.ExecuteInTransaction(ctx, db, func(ctx context.Context) error {
+		return writer.Write(ctx, "test.committed", map[string]string{"id": "2"})
+	})
+	if err != nil {
+		t.Fatalf("ExecuteInTransaction: %v", err)
+	}
+
+	var names []string
+	if err := db.SelectContext(ctx, &names, `SELECT event_name FROM outbox`); err != nil {
+		t.Fatalf("select outbox: %v", err)
+	}
+	if len(names) != 1 || names[0] != "test.committed" {
+		t.Fatalf("got outbox events %v, want only test.committed", names)
+	}
+}
+
+func TestOutboxRelayMarksDeliveredMessagesPublished(t *testing.T) {
+	db := openOutbox(t)
+	ctx := context.Background()
+
+	if err := database.NewOutboxWriter(db).Write(ctx, "test.delivered", struct{}{}); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	var got []string
+	relay := database.NewOutboxRelay(db, database.OutboxSinkFunc(func(_ context.Context, msg *database.OutboxMessage) error {
+		got = append(got, msg.EventName)
+		return nil
+	}), database.OutboxRelayOptions{})
+
+	delivered, err := relay.ProcessBatch(ctx)
+	if err != nil || delivered != 1 {
+		t.Fatalf("ProcessBatch: got %d, %v, want 1 delivered", delivered, err)
+	}
+	if len(got) != 1 || got[0] != "test.delivered" {
+		t.Fatalf("sink got %v, want [test.delivered]", got)
+	}
+
+	row := getOutboxRow(t, db, "test.delivered")
+	if row.PublishedAt == nil || row.Attempts != 1 {
+		t.Fatalf("got %+v, want published after 1 attempt", row)
+	}
+
+	// published messages are not delivered again
+	if delivered, err := relay.ProcessBatch(ctx); err != nil || delivered != 0 {
+		t.Fatalf("second ProcessBatch: got %d, %v, want nothing delivered", delivered, err)
+	}
+}
+
+func TestOutboxRelayBacksOffOnSinkFailure(t *testing.T) {
+	db := openOutbox(t)
+	ctx := context.Background()
+
+	if err := database.NewOutboxWriter(db).Write(ctx, "test.failing", struct{}{}); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	opts := database.OutboxRelayOptions{MinBackoff: time.Minute, MaxBackoff: time.Hour}
+	relay := database.NewOutboxRelay(db, database.OutboxSinkFunc(func(context.Context, *database.OutboxMessage) error {
+		return errors.New("sink unavailable")
+	}), opts)
+
+	var previousDelay time.Duration
+	for attempt := 1; attempt <= 2; attempt++ {
+		start := time.Now()
+		if delivered, err := relay.ProcessBatch(ctx); err != nil || delivered != 0 {
+			t.Fatalf("attempt %d: got %d, %v, want nothing delivered", attempt, delivered, err)
+		}
+
+		row := getOutboxRow(t, db, "test.failing")
+		if row.Attempts != attempt || row.PublishedAt != nil {
+			t.Fatalf("attempt %d: got %+v, want %d attempts and unpublished", attempt, row, attempt)
+		}
+		delay := row.NextAttemptAt.Sub(start)
+		if delay < relay.Backoff(attempt) || delay <= previousDelay {
+			t.Fatalf("attempt %d: next attempt in %s, want at least %s and more than %s", attempt, delay, relay.Backoff(attempt), previousDelay)
+		}
+		previousDelay = delay
+
+		// the message is not due again until its backoff expires
+		if delivered, err := relay.ProcessBatch(ctx); err != nil || delivered != 0 {
+			t.Fatalf("attempt %d: message retried before its backoff expired (%d, %v)", attempt, delivered, err)
+		}
+		if row := getOutboxRow(t, db, "test.failing"); row.Attempts != attempt {
+			t.Fatalf("attempt %d: message retried before its backoff expired", attempt)
+		}
+
+		if _, err := db.ExecContext(ctx, `UPDATE outbox SET next_attempt_at = NOW()`); err != nil {
+			t.Fatalf("make message due: %v", err)
+		}
+	}
+}
+
+func TestOutboxRelaySkipsExhaustedMessages(t *testing.T) {
+	db := openOutbox(t)
+	ctx := context.Background()
+
+	_, err := db.ExecContext(ctx, `INSERT INTO outbox (event_name, payload, attempts) VALUES ('test.exhausted', '{}', 3)`)
+	if err != nil {
+		t.Fatalf("insert message: %v", err)
+	}
+
+	calls := 0
+	relay := database.NewOutboxRelay(db, database.OutboxSinkFunc(func(context.Context, *database.OutboxMessage) error {
+		calls++
+		return nil
+	}), database.OutboxRelayOptions{MaxAttempts: 3})
+
+	if delivered, err := relay.ProcessBatch(ctx); err != nil || delivered != 0 || calls != 0 {
+		t.Fatalf("ProcessBatch: got %d delivered, %d sink calls, %v; want the exhausted message skipped", delivered, calls, err)
+	}
+	if row := getOutboxRow(t, db, "test.exhausted"); row.Attempts != 3 || row.PublishedAt != nil {
+		t.Fatalf("exhausted message was touched: %+v", row)
+	}
+}
+
+func TestOutboxRelayBackoff(t *testing.T) {
+	relay := database.NewOutboxRelay(nil, nil, database.OutboxRelayOptions{MinBackoff: time.Second, MaxBackoff: 5 * time.Second})
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{4, 5 * time.Second},
+		{20, 5 * time.Second},
+	}
+	for _, tt := range tests {
+		if got := relay.Backoff(tt.attempts); got != tt.want {
+			t.Errorf("Backoff(%d) = %s, want %s", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// redactedArg replaces argument values that may hold personal data or secrets
+const redactedArg = "****"
+
+// LoggingHook logs every statement at debug level with its duration, row
+// count and redacted arguments, and at warn level when it is slower than
+// SlowThreshold. Failed statements are logged at debug level only; callers
+// decide whether an error is worth reporting.
+type LoggingHook struct {
+	db *DB
+	// SlowThreshold is the duration above which a statement is logged as
+	// slow; zero or negative disables slow-query warnings
+	SlowThreshold time.Duration
+}
+
+// NewLoggingHook returns a hook logging through db's logger, see DB.Logger
+func NewLoggingHook(db *DB, slowThreshold time.Duration) *LoggingHook {
+	return &LoggingHook{db: db, SlowThreshold: slowThreshold}
+}
+
+// BeforeQuery implements QueryHook
+func (h *LoggingHook) BeforeQuery(ctx context.Context, _ *QueryEvent) context.Context {
+	return ctx
+}
+
+// AfterQuery implements QueryHook
+func (h *LoggingHook) AfterQuery(ctx context.Context, event *QueryEvent) {
+	slow := h.SlowThreshold > 0 && event.Duration >= h.SlowThreshold
+	level := slog.LevelDebug
+	message := "SQL statement"
+	if slow {
+		level = slog.LevelWarn
+		message = "slow SQL statement"
+	}
+
+	logger := h.db.Logger(ctx)
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []any{
+		"method", event.Method,
+		"query", strings.Join(strings.Fields(event.Query), " "),
+		"args", RedactArgs(event.Args),
+		"duration_ms", float64(event.Duration.Microseconds()) / 1000,
+		"in_tx", event.InTx,
+	}
+	if event.Rows >= 0 {
+		attrs = append(attrs, "rows", event.Rows)
+	}
+	if slow {
+		attrs = append(attrs, "threshold_ms", h.SlowThreshold.Milliseconds())
+	}
+	if event.Err != nil {
+		attrs = append(attrs, "error", event.Err)
+	}
+
+	logger.Log(ctx, level, "LoggingHook.AfterQuery", append([]any{"message", message}, attrs...)...)
+}
+
+// RedactArgs renders statement arguments for logging. Numbers, booleans,
+// times and NULLs are shown as is; strings and bytes are masked, and
+// structs passed to NamedExec are reduced to their type name.
+func RedactArgs(args []any) []string {
+	rendered := make([]string, len(args))
+	for i, arg := range args {
+		rendered[i] = redactArg(arg)
+	}
+	return rendered
+}
+
+func redactArg(arg any) string {
+	if valuer, ok := arg.(driver.Valuer); ok {
+		value, err := valuer.Value()
+		if err != nil {
+			return redactedArg
+		}
+		arg = value
+	}
+
+	switch v := arg.(type) {
+	case nil:
+		return "NULL"
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return fmt.Sprint(v)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	case string, []byte:
+		return redactedArg
+	}
+
+	t := reflect.TypeOf(arg)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() == reflect.Struct || t.Kind() == reflect.Map {
+		return "<" + t.String() + ">"
+	}
+	return redactedArg
+}
@@ -0,0 +1,146 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type namedUser struct {
+	Email    string
+	Password string
+}
+
+// failingValuer fails to produce a driver value
+type failingValuer struct{}
+
+func (failingValuer) Value() (driver.Value, error) { return nil, errors.New("no value") }
+
+func TestRedactArgs(t *testing.T) {
+	at := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		arg  any
+		want string
+	}{
+		{"nil", nil, "NULL"},
+		{"int", 42, "42"},
+		{"int64", int64(-7), "-7"},
+		{"float", 1.5, "1.5"},
+		{"bool", true, "true"},
+		{"time", at, "2024-05-01T12:30:00Z"},
+		{"string", "jane@example.com", redactedArg},
+		{"password", "Secret123!", redactedArg},
+		{"bytes", []byte("token"), redactedArg},
+		{"string slice", []string{"a", "b"}, redactedArg},
+		{"valid NullString", sql.NullString{String: "secret", Valid: true}, redactedArg},
+		{"null NullString", sql.NullString{}, "NULL"},
+		{"valid NullInt64", sql.NullInt64{Int64: 3, Valid: true}, "3"},
+		{"failing valuer", failingValuer{}, redactedArg},
+		{"named struct", namedUser{Email: "jane@example.com", Password: "Secret123!"}, "<database.namedUser>"},
+		{"named struct pointer", &namedUser{Password: "Secret123!"}, "<database.namedUser>"},
+		{"named map", map[string]any{"password": "Secret123!"}, "<map[string]interface {}>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RedactArgs([]any{tt.arg})
+			if !reflect.DeepEqual(got, []string{tt.want}) {
+				t.Fatalf("RedactArgs(%#v) = %q, want %q", tt.arg, got, tt.want)
+			}
+		})
+	}
+}
+
+// newLoggedDB returns a DB whose logger writes JSON records at level or
+// above into the returned buffer
+func newLoggedDB(level slog.Level) (*DB, *bytes.Buffer) {
+	var buf bytes.Buffer
+	db := &DB{}
+	db.SetLogger(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: level})))
+	return db, &buf
+}
+
+func decodeRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var record map[string]any
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("invalid log line %q: %v", line, err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestLoggingHookSlowThreshold(t *testing.T) {
+	tests := []struct {
+		name      string
+		logLevel  slog.Level
+		threshold time.Duration
+		duration  time.Duration
+		wantLevel string
+		wantMsg   string
+	}{
+		{"slow statement warns", slog.LevelInfo, 100 * time.Millisecond, 250 * time.Millisecond, "WARN", "slow SQL statement"},
+		{"at threshold warns", slog.LevelInfo, 100 * time.Millisecond, 100 * time.Millisecond, "WARN", "slow SQL statement"},
+		{"fast statement is silent at info", slog.LevelInfo, 100 * time.Millisecond, 5 * time.Millisecond, "", ""},
+		{"fast statement logs at debug", slog.LevelDebug, 100 * time.Millisecond, 5 * time.Millisecond, "DEBUG", "SQL statement"},
+		{"zero threshold disables warnings", slog.LevelInfo, 0, time.Hour, "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, buf := newLoggedDB(tt.logLevel)
+			hook := NewLoggingHook(db, tt.threshold)
+
+			hook.AfterQuery(context.Background(), &QueryEvent{
+				Method:   "Exec",
+				Query:    "UPDATE users\n\tSET password_hash = $1 WHERE id = $2",
+				Args:     []any{"$2a$10$hash", 42},
+				Duration: tt.duration,
+				Rows:     1,
+			})
+
+			records := decodeRecords(t, buf)
+			if tt.wantLevel == "" {
+				if len(records) != 0 {
+					t.Fatalf("got log records %v, want none", records)
+				}
+				return
+			}
+			if len(records) != 1 {
+				t.Fatalf("got %d log records, want 1: %s", len(records), buf)
+			}
+			record := records[0]
+			if record["level"] != tt.wantLevel || record["message"] != tt.wantMsg {
+				t.Errorf("got level %v message %v, want %s %q", record["level"], record["message"], tt.wantLevel, tt.wantMsg)
+			}
+			if record["query"] != "UPDATE users SET password_hash = $1 WHERE id = $2" {
+				t.Errorf("query not collapsed to one line: %v", record["query"])
+			}
+			if strings.Contains(buf.String(), "$2a$10$hash") {
+				t.Errorf("log contains an unredacted argument: %s", buf)
+			}
+			if args, _ := record["args"].([]any); len(args) != 2 || args[0] != redactedArg || args[1] != "42" {
+				t.Errorf("args = %v, want [%s 42]", record["args"], redactedArg)
+			}
+			_, hasThreshold := record["threshold_ms"]
+			if hasThreshold != (tt.wantLevel == "WARN") {
+				t.Errorf("threshold_ms present = %v on a %s record", hasThreshold, tt.wantLevel)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package database
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SortField is a single ORDER BY term. Column is always taken from a
+// whitelist, never from user input, so it is safe to interpolate.
+type SortField struct {
+	Column string
+	Desc   bool
+}
+
+// ParseSort parses a comma-separated sort spec such as "created_at,-last_name"
+// where a leading '-' means descending. allowed maps the public field names to
+// SQL columns; any field not in allowed, or listed twice, is rejected with
+// ErrInvalidInput.
+func ParseSort(spec string, allowed map[string]string) ([]SortField, error) {
+	var fields []SortField
+	seen := make(map[string]bool)
+
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		desc := false
+		switch part[0] {
+		case '-':
+			desc = true
+			part = part[1:]
+		case '+':
+			part = part[1:]
+		}
+
+		column, ok := allowed[part]
+		if !ok {
+			return nil, fmt.Errorf("%w: cannot sort by %q", ErrInvalidInput, part)
+		}
+		if seen[column] {
+			return nil, fmt.Errorf("%w: %q is sorted by more than once", ErrInvalidInput, part)
+		}
+		seen[column] = true
+		fields = append(fields, SortField{Column: column, Desc: desc})
+	}
+
+	return fields, nil
+}
+
+// OrderByClause renders fields as the body of an ORDER BY clause
+func OrderByClause(fields []SortField) string {
+	terms := make([]string, 0, len(fields))
+	for _, field := range fields {
+		direction := "ASC"
+		if field.Desc {
+			direction = "DESC"
+		}
+		terms = append(terms, fmt.Sprintf("%s %s", field.Column, direction))
+	}
+	return strings.Join(terms, ", ")
+}
@@ -0,0 +1,64 @@
+package database
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseSort(t *testing.T) {
+	allowed := map[string]string{
+		"created_at": "created_at",
+		"name":       "last_name",
+	}
+
+	tests := []struct {
+		name    string
+		spec    string
+		want    []SortField
+		wantErr bool
+	}{
+		{name: "empty", spec: ""},
+		{name: "ascending", spec: "created_at", want: []SortField{{Column: "created_at"}}},
+		{name: "explicit ascending", spec: "+created_at", want: []SortField{{Column: "created_at"}}},
+		{name: "descending", spec: "-created_at", want: []SortField{{Column: "created_at", Desc: true}}},
+		{name: "mapped column", spec: "name", want: []SortField{{Column: "last_name"}}},
+		{
+			name: "several fields",
+			spec: " -name , created_at ",
+			want: []SortField{{Column: "last_name", Desc: true}, {Column: "created_at"}},
+		},
+		{name: "blank terms ignored", spec: ",created_at,", want: []SortField{{Column: "created_at"}}},
+		{name: "unknown field", spec: "password_hash", wantErr: true},
+		{name: "column name instead of field", spec: "last_name", wantErr: true},
+		{name: "duplicate field", spec: "created_at,-created_at", wantErr: true},
+		{name: "statement injection", spec: "id;drop table users", wantErr: true},
+		{name: "expression injection", spec: "created_at desc, (select 1)", wantErr: true},
+		{name: "comment injection", spec: "created_at--", wantErr: true},
+		{name: "lone sign", spec: "-", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSort(tt.spec, allowed)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidInput) {
+					t.Fatalf("ParseSort(%q): got %v, %v, want ErrInvalidInput", tt.spec, got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseSort(%q): %v", tt.spec, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("ParseSort(%q) = %+v, want %+v", tt.spec, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderByClause(t *testing.T) {
+	got := OrderByClause([]SortField{{Column: "last_name", Desc: true}, {Column: "created_at"}})
+	if want := "last_name DESC, created_at ASC"; got != want {
+		t.Fatalf("OrderByClause = %q, want %q", got, want)
+	}
+}
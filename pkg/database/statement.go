@@ -0,0 +1,115 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Tx wraps sqlx.Tx so statements run inside a transaction pass through the
+// same query hooks as statements run on DB. GetTxFromContext returns it.
+type Tx struct {
+	*sqlx.Tx
+
+	// db provides the query hooks; nil runs statements without hooks
+	db *DB
+}
+
+// GetContext runs a query expected to return one row into dest
+func (db *DB) GetContext(ctx context.Context, dest any, query string, args ...any) error {
+	event := &QueryEvent{Method: "Get", Query: query, Args: args}
+	return db.runQuery(ctx, event, func(ctx context.Context) (int64, error) {
+		return rowsReturned(dest, db.DB.GetContext(ctx, dest, query, args...))
+	})
+}
+
+// SelectContext runs a query returning rows into dest
+func (db *DB) SelectContext(ctx context.Context, dest any, query string, args ...any) error {
+	event := &QueryEvent{Method: "Select", Query: query, Args: args}
+	return db.runQuery(ctx, event, func(ctx context.Context) (int64, error) {
+		return rowsReturned(dest, db.DB.SelectContext(ctx, dest, query, args...))
+	})
+}
+
+// ExecContext runs a statement without returning rows
+func (db *DB) ExecContext(ctx context.Context, query string, args ...any) (result sql.Result, err error) {
+	event := &QueryEvent{Method: "Exec", Query: query, Args: args}
+	err = db.runQuery(ctx, event, func(ctx context.Context) (int64, error) {
+		result, err = db.DB.ExecContext(ctx, query, args...)
+		return rowsAffected(result, err)
+	})
+	return result, err
+}
+
+// NamedExecContext runs a statement with named parameters bound from arg
+func (db *DB) NamedExecContext(ctx context.Context, query string, arg any) (result sql.Result, err error) {
+	event := &QueryEvent{Method: "NamedExec", Query: query, Args: []any{arg}}
+	err = db.runQuery(ctx, event, func(ctx context.Context) (int64, error) {
+		result, err = db.DB.NamedExecContext(ctx, query, arg)
+		return rowsAffected(result, err)
+	})
+	return result, err
+}
+
+// GetContext runs a query expected to return one row into dest
+func (tx *Tx) GetContext(ctx context.Context, dest any, query string, args ...any) error {
+	event := &QueryEvent{Method: "Get", Query: query, Args: args, InTx: true}
+	return tx.db.runQuery(ctx, event, func(ctx context.Context) (int64, error) {
+		return rowsReturned(dest, tx.Tx.GetContext(ctx, dest, query, args...))
+	})
+}
+
+// SelectContext runs a query returning rows into dest
+func (tx *Tx) SelectContext(ctx context.Context, dest any, query string, args ...any) error {
+	event := &QueryEvent{Method: "Select", Query: query, Args: args, InTx: true}
+	return tx.db.runQuery(ctx, event, func(ctx context.Context) (int64, error) {
+		return rowsReturned(dest, tx.Tx.SelectContext(ctx, dest, query, args...))
+	})
+}
+
+// ExecContext runs a statement without returning rows
+func (tx *Tx) ExecContext(ctx context.Context, query string, args ...any) (result sql.Result, err error) {
+	event := &QueryEvent{Method: "Exec", Query: query, Args: args, InTx: true}
+	err = tx.db.runQuery(ctx, event, func(ctx context.Context) (int64, error) {
+		result, err = tx.Tx.ExecContext(ctx, query, args...)
+		return rowsAffected(result, err)
+	})
+	return result, err
+}
+
+// NamedExecContext runs a statement with named parameters bound from arg
+func (tx *Tx) NamedExecContext(ctx context.Context, query string, arg any) (result sql.Result, err error) {
+	event := &QueryEvent{Method: "NamedExec", Query: query, Args: []any{arg}, InTx: true}
+	err = tx.db.runQuery(ctx, event, func(ctx context.Context) (int64, error) {
+		result, err = tx.Tx.NamedExecContext(ctx, query, arg)
+		return rowsAffected(result, err)
+	})
+	return result, err
+}
+
+// rowsAffected reads the affected row count of a successful statement
+func rowsAffected(result sql.Result, err error) (int64, error) {
+	if err != nil || result == nil {
+		return -1, err
+	}
+	rows, rowsErr := result.RowsAffected()
+	if rowsErr != nil {
+		return -1, nil
+	}
+	return rows, nil
+}
+
+// rowsReturned counts the rows scanned into dest: the slice length for
+// Select, one for a successful Get
+func rowsReturned(dest any, err error) (int64, error) {
+	if err != nil {
+		return -1, err
+	}
+	v := reflect.Indirect(reflect.ValueOf(dest))
+	if v.Kind() == reflect.Slice {
+		return int64(v.Len()), nil
+	}
+	return 1, nil
+}
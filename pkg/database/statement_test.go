@@ -0,0 +1,154 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// stubDriver is an in-memory driver whose statements succeed, affecting or
+// returning a single row, so hooks can be tested without a database server
+type stubDriver struct{}
+
+func (stubDriver) Open(string) (driver.Conn, error) { return stubConn{}, nil }
+
+type stubConn struct{}
+
+func (stubConn) Prepare(string) (driver.Stmt, error) { return stubStmt{}, nil }
+func (stubConn) Close() error                        { return nil }
+func (stubConn) Begin() (driver.Tx, error)           { return stubTx{}, nil }
+
+type stubTx struct{}
+
+func (stubTx) Commit() error   { return nil }
+func (stubTx) Rollback() error { return nil }
+
+type stubStmt struct{}
+
+func (stubStmt) Close() error                               { return nil }
+func (stubStmt) NumInput() int                              { return -1 }
+func (stubStmt) Exec([]driver.Value) (driver.Result, error) { return driver.RowsAffected(1), nil }
+func (stubStmt) Query([]driver.Value) (driver.Rows, error)  { return &stubRows{}, nil }
+
+type stubRows struct{ done bool }
+
+func (*stubRows) Columns() []string { return []string{"n"} }
+func (*stubRows) Close() error      { return nil }
+
+func (r *stubRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = int64(1)
+	return nil
+}
+
+func init() {
+	sql.Register("database_stub", stubDriver{})
+}
+
+// openStubDB returns a DB on the stub driver running hooks
+func openStubDB(t *testing.T, hooks ...QueryHook) *DB {
+	t.Helper()
+
+	sqlDB, err := sql.Open("database_stub", "")
+	if err != nil {
+		t.Fatalf("open stub database: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+	return &DB{DB: sqlx.NewDb(sqlDB, "postgres"), hooks: hooks}
+}
+
+// recordingHook records every event it sees after the statement ran
+type recordingHook struct {
+	events []QueryEvent
+}
+
+func (h *recordingHook) BeforeQuery(ctx context.Context, _ *QueryEvent) context.Context {
+	return ctx
+}
+
+func (h *recordingHook) AfterQuery(_ context.Context, event *QueryEvent) {
+	h.events = append(h.events, *event)
+}
+
+// runStatements runs one statement through each DB method of q
+func runStatements(t *testing.T, ctx context.Context, q interface {
+	GetContext(ctx context.Context, dest any, query string, args ...any) error
+	SelectContext(ctx context.Context, dest any, query string, args ...any) error
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	NamedExecContext(ctx context.Context, query string, arg any) (sql.Result, error)
+}) {
+	t.Helper()
+
+	var n int
+	if err := q.GetContext(ctx, &n, "SELECT 1 WHERE $1", 7); err != nil {
+		t.Fatalf("GetContext: %v", err)
+	}
+	var ns []int
+	if err := q.SelectContext(ctx, &ns, "SELECT 1"); err != nil {
+		t.Fatalf("SelectContext: %v", err)
+	}
+	if _, err := q.ExecContext(ctx, "UPDATE t SET n = $1", 7); err != nil {
+		t.Fatalf("ExecContext: %v", err)
+	}
+	if _, err := q.NamedExecContext(ctx, "UPDATE t SET n = :n", map[string]any{"n": 7}); err != nil {
+		t.Fatalf("NamedExecContext: %v", err)
+	}
+}
+
+func TestHooksRunForDBAndTx(t *testing.T) {
+	hook := &recordingHook{}
+	db := openStubDB(t, hook)
+
+	runStatements(t, context.Background(), db)
+	err := ExecuteInTransaction(context.Background(), db, func(ctx context.Context) error {
+		tx := GetTxFromContext(ctx)
+		if tx == nil {
+			t.Fatal("no transaction in context")
+		}
+		runStatements(t, ctx, tx)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ExecuteInTransaction: %v", err)
+	}
+
+	methods := []string{"Get", "Select", "Exec", "NamedExec"}
+	if len(hook.events) != 2*len(methods) {
+		t.Fatalf("hook saw %d statements, want %d", len(hook.events), 2*len(methods))
+	}
+	for i, event := range hook.events {
+		wantInTx := i >= len(methods)
+		if event.Method != methods[i%len(methods)] || event.InTx != wantInTx {
+			t.Errorf("event %d: method %s in_tx %v, want %s in_tx %v", i, event.Method, event.InTx, methods[i%len(methods)], wantInTx)
+		}
+		if event.Rows != 1 || event.Err != nil || event.Start.IsZero() {
+			t.Errorf("event %d (%s): rows %d err %v start %v, want one row, no error and a start time", i, event.Method, event.Rows, event.Err, event.Start)
+		}
+	}
+}
+
+func TestWithTransactionRunsWithoutHooks(t *testing.T) {
+	hook := &recordingHook{}
+	db := openStubDB(t, hook)
+
+	sqlxTx, err := db.BeginTxx(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+	defer sqlxTx.Rollback()
+
+	// the Tx carries no DB, so runQuery is called on a nil *DB
+	ctx := WithTransaction(context.Background(), sqlxTx)
+	runStatements(t, ctx, GetTxFromContext(ctx))
+
+	if len(hook.events) != 0 {
+		t.Fatalf("hooks ran %d times for a transaction added with WithTransaction", len(hook.events))
+	}
+}
@@ -43,14 +43,14 @@ func ExecuteInTransaction(ctx context.Context, db *DB, fn func(ctx context.Conte
 	}
 
 	// Add transaction to context
-	txCtx := context.WithValue(ctx, TxKey{}, tx)
+	txCtx := context.WithValue(ctx, TxKey{}, &Tx{Tx: tx, db: db})
 
 	// Set up defer for rollback in case of panic
 	defer func() {
 		if r := recover(); r != nil {
 			if rollbackErr := tx.Rollback(); rollbackErr != nil {
 				// Log rollback error but don't override the panic
-				fmt.Printf("failed to rollback transaction after panic: %v\n", rollbackErr)
+				db.Logger(ctx).Error("ExecuteInTransaction", "message", "failed to rollback transaction after panic", "error", rollbackErr)
 			}
 			panic(r) // Re-panic
 		}
@@ -88,14 +88,14 @@ func ExecuteInTransactionWithOptions(ctx context.Context, db *DB, opts *sql.TxOp
 	}
 
 	// Add transaction to context
-	txCtx := context.WithValue(ctx, TxKey{}, tx)
+	txCtx := context.WithValue(ctx, TxKey{}, &Tx{Tx: tx, db: db})
 
 	// Set up defer for rollback in case of panic
 	defer func() {
 		if r := recover(); r != nil {
 			if rollbackErr := tx.Rollback(); rollbackErr != nil {
 				// Log rollback error but don't override the panic
-				fmt.Printf("failed to rollback transaction after panic: %v\n", rollbackErr)
+				db.Logger(ctx).Error("ExecuteInTransactionWithOptions", "message", "failed to rollback transaction after panic", "error", rollbackErr)
 			}
 			panic(r) // Re-panic
 		}
@@ -119,16 +119,17 @@ func ExecuteInTransactionWithOptions(ctx context.Context, db *DB, opts *sql.TxOp
 }
 
 // GetTxFromContext retrieves the transaction from the context
-func GetTxFromContext(ctx context.Context) *sqlx.Tx {
-	if tx, ok := ctx.Value(TxKey{}).(*sqlx.Tx); ok {
+func GetTxFromContext(ctx context.Context) *Tx {
+	if tx, ok := ctx.Value(TxKey{}).(*Tx); ok {
 		return tx
 	}
 	return nil
 }
 
-// WithTransaction adds a transaction to the context
+// WithTransaction adds a transaction to the context. Statements on it skip
+// the query hooks; use ExecuteInTransaction to keep them.
 func WithTransaction(ctx context.Context, tx *sqlx.Tx) context.Context {
-	return context.WithValue(ctx, TxKey{}, tx)
+	return context.WithValue(ctx, TxKey{}, &Tx{Tx: tx})
 }
 
 // TransactionOptions provides common transaction option presets
@@ -0,0 +1,38 @@
+// Package logging binds a *slog.Logger to a context.Context, so request
+// attributes such as the request ID follow the request into services and
+// repositories.
+package logging
+
+import (
+	"context"
+	"log/slog"
+)
+
+type loggerKey struct{}
+
+// WithLogger returns ctx carrying logger
+func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// With returns ctx carrying its logger extended with args, as in slog.Logger.With
+func With(ctx context.Context, args ...any) context.Context {
+	return WithLogger(ctx, FromContext(ctx).With(args...))
+}
+
+// FromContext returns the logger bound to ctx, or slog.Default()
+func FromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := Lookup(ctx); ok {
+		return logger
+	}
+	return slog.Default()
+}
+
+// Lookup returns the logger bound to ctx and whether there is one
+func Lookup(ctx context.Context) (*slog.Logger, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	logger, ok := ctx.Value(loggerKey{}).(*slog.Logger)
+	return logger, ok && logger != nil
+}
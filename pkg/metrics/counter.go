@@ -0,0 +1,84 @@
+package metrics
+
+import (
+	"bufio"
+	"sort"
+	"sync"
+)
+
+// CounterVec is a family of monotonically increasing counters partitioned by labels
+type CounterVec struct {
+	metricName string
+	help       string
+	labelNames []string
+
+	mu     sync.Mutex
+	series map[string]*counterSeries
+}
+
+type counterSeries struct {
+	labelValues []string
+	value       float64
+}
+
+// NewCounterVec registers a counter family. Counter names should end in _total.
+func (r *Registry) NewCounterVec(name, help string, labelNames ...string) *CounterVec {
+	c := &CounterVec{
+		metricName: name,
+		help:       help,
+		labelNames: labelNames,
+		series:     make(map[string]*counterSeries),
+	}
+	r.register(c)
+	return c
+}
+
+// Inc adds one to the counter with the given label values
+func (c *CounterVec) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+// Add adds v, which must not be negative, to the counter with the given label values
+func (c *CounterVec) Add(v float64, labelValues ...string) {
+	checkLabels(c.metricName, c.labelNames, labelValues)
+	if v < 0 {
+		return
+	}
+
+	key := labelKey(labelValues)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, ok := c.series[key]
+	if !ok {
+		s = &counterSeries{labelValues: append([]string(nil), labelValues...)}
+		c.series[key] = s
+	}
+	s.value += v
+}
+
+func (c *CounterVec) name() string {
+	return c.metricName
+}
+
+func (c *CounterVec) write(w *bufio.Writer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	writeHeader(w, c.metricName, c.help, "counter")
+	for _, key := range sortedKeys(c.series) {
+		s := c.series[key]
+		writeSample(w, c.metricName, c.labelNames, s.labelValues, s.value)
+	}
+}
+
+// sortedKeys returns the keys of m in order, so output is stable between scrapes
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
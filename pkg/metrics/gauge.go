@@ -0,0 +1,31 @@
+package metrics
+
+import "bufio"
+
+// funcMetric reads its value from a function at scrape time
+type funcMetric struct {
+	metricName string
+	help       string
+	typ        string
+	value      func() float64
+}
+
+// NewGaugeFunc registers a gauge whose value is read from fn on every scrape
+func (r *Registry) NewGaugeFunc(name, help string, fn func() float64) {
+	r.register(&funcMetric{metricName: name, help: help, typ: "gauge", value: fn})
+}
+
+// NewCounterFunc registers a counter whose value is read from fn on every
+// scrape, for totals maintained elsewhere such as sql.DBStats
+func (r *Registry) NewCounterFunc(name, help string, fn func() float64) {
+	r.register(&funcMetric{metricName: name, help: help, typ: "counter", value: fn})
+}
+
+func (m *funcMetric) name() string {
+	return m.metricName
+}
+
+func (m *funcMetric) write(w *bufio.Writer) {
+	writeHeader(w, m.metricName, m.help, m.typ)
+	writeSample(w, m.metricName, nil, nil, m.value())
+}
@@ -0,0 +1,102 @@
+package metrics
+
+import (
+	"bufio"
+	"math"
+	"sort"
+	"sync"
+)
+
+// DefaultBuckets are latency buckets in seconds, from 5ms to 10s
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// HistogramVec is a family of histograms partitioned by labels
+type HistogramVec struct {
+	metricName string
+	help       string
+	labelNames []string
+	buckets    []float64
+
+	mu     sync.Mutex
+	series map[string]*histogramSeries
+}
+
+type histogramSeries struct {
+	labelValues []string
+	counts      []uint64 // per bucket, not cumulative
+	count       uint64
+	sum         float64
+}
+
+// NewHistogramVec registers a histogram family with the given upper bounds;
+// nil buckets means DefaultBuckets
+func (r *Registry) NewHistogramVec(name, help string, buckets []float64, labelNames ...string) *HistogramVec {
+	if buckets == nil {
+		buckets = DefaultBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+
+	h := &HistogramVec{
+		metricName: name,
+		help:       help,
+		labelNames: labelNames,
+		buckets:    buckets,
+		series:     make(map[string]*histogramSeries),
+	}
+	r.register(h)
+	return h
+}
+
+// Observe records v in the histogram with the given label values
+func (h *HistogramVec) Observe(v float64, labelValues ...string) {
+	checkLabels(h.metricName, h.labelNames, labelValues)
+	key := labelKey(labelValues)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	s, ok := h.series[key]
+	if !ok {
+		s = &histogramSeries{
+			labelValues: append([]string(nil), labelValues...),
+			counts:      make([]uint64, len(h.buckets)),
+		}
+		h.series[key] = s
+	}
+
+	if i := sort.SearchFloat64s(h.buckets, v); i < len(h.buckets) {
+		s.counts[i]++
+	}
+	s.count++
+	s.sum += v
+}
+
+func (h *HistogramVec) name() string {
+	return h.metricName
+}
+
+func (h *HistogramVec) write(w *bufio.Writer) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	writeHeader(w, h.metricName, h.help, "histogram")
+
+	labelNames := append(append([]string(nil), h.labelNames...), "le")
+	for _, key := range sortedKeys(h.series) {
+		s := h.series[key]
+		labelValues := append(append([]string(nil), s.labelValues...), "")
+
+		var cumulative uint64
+		for i, bound := range h.buckets {
+			cumulative += s.counts[i]
+			labelValues[len(labelValues)-1] = formatFloat(bound)
+			writeSample(w, h.metricName+"_bucket", labelNames, labelValues, float64(cumulative))
+		}
+		labelValues[len(labelValues)-1] = formatFloat(math.Inf(1))
+		writeSample(w, h.metricName+"_bucket", labelNames, labelValues, float64(s.count))
+
+		writeSample(w, h.metricName+"_sum", h.labelNames, s.labelValues, s.sum)
+		writeSample(w, h.metricName+"_count", h.labelNames, s.labelValues, float64(s.count))
+	}
+}
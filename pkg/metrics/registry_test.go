@@ -0,0 +1,95 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+)
+
+// golden is the expected exposition of newTestRegistry
+const golden = `# HELP app_up Whether the app is up.
+# TYPE app_up gauge
+app_up 1
+# HELP http_request_duration_seconds Request latency.
+# TYPE http_request_duration_seconds histogram
+http_request_duration_seconds_bucket{route="/a",le="0.1"} 2
+http_request_duration_seconds_bucket{route="/a",le="1"} 3
+http_request_duration_seconds_bucket{route="/a",le="+Inf"} 4
+http_request_duration_seconds_sum{route="/a"} 6.65
+http_request_duration_seconds_count{route="/a"} 4
+http_request_duration_seconds_bucket{route="/b",le="0.1"} 0
+http_request_duration_seconds_bucket{route="/b",le="1"} 0
+http_request_duration_seconds_bucket{route="/b",le="+Inf"} 1
+http_request_duration_seconds_sum{route="/b"} 2
+http_request_duration_seconds_count{route="/b"} 1
+# HELP http_requests_total Requests served.\nBy route and "status".
+# TYPE http_requests_total counter
+http_requests_total{route="/a",status="200"} 2
+http_requests_total{route="/a",status="500"} 1
+http_requests_total{route="/quote\"back\\slash\nline",status="200"} 1
+`
+
+func newTestRegistry() *Registry {
+	r := NewRegistry()
+
+	// registered out of order: output is sorted by family name
+	requests := r.NewCounterVec("http_requests_total", "Requests served.\nBy route and \"status\".", "route", "status")
+	latency := r.NewHistogramVec("http_request_duration_seconds", "Request latency.", []float64{1, 0.1}, "route")
+	r.NewGaugeFunc("app_up", "Whether the app is up.", func() float64 { return 1 })
+
+	requests.Inc("/quote\"back\\slash\nline", "200")
+	requests.Inc("/a", "500")
+	requests.Add(2, "/a", "200")
+	requests.Add(-1, "/a", "200") // counters never decrease
+
+	latency.Observe(2, "/b")
+	latency.Observe(0.05, "/a")
+	latency.Observe(0.1, "/a") // bounds are inclusive
+	latency.Observe(1, "/a")
+	latency.Observe(5.5, "/a")
+
+	return r
+}
+
+func TestWriteTextGolden(t *testing.T) {
+	r := newTestRegistry()
+
+	var first strings.Builder
+	if err := r.WriteText(&first); err != nil {
+		t.Fatalf("WriteText: %v", err)
+	}
+	if first.String() != golden {
+		t.Fatalf("WriteText output differs from golden\ngot:\n%s\nwant:\n%s", first.String(), golden)
+	}
+
+	// output is stable between scrapes
+	var second strings.Builder
+	if err := r.WriteText(&second); err != nil {
+		t.Fatalf("WriteText: %v", err)
+	}
+	if second.String() != first.String() {
+		t.Fatalf("WriteText output changed between scrapes\nfirst:\n%s\nsecond:\n%s", first.String(), second.String())
+	}
+}
+
+func TestRegisterDuplicatePanics(t *testing.T) {
+	r := NewRegistry()
+	r.NewCounterVec("jobs_total", "Jobs run.")
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("registering jobs_total twice did not panic")
+		}
+	}()
+	r.NewHistogramVec("jobs_total", "Jobs run.", nil)
+}
+
+func TestLabelCountMismatchPanics(t *testing.T) {
+	counter := NewRegistry().NewCounterVec("jobs_total", "Jobs run.", "job")
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Inc with the wrong number of label values did not panic")
+		}
+	}()
+	counter.Inc("cleanup", "extra")
+}
@@ -0,0 +1,72 @@
+package tracing
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// Exporter receives every sampled span when it ends. Implementations must
+// be safe for concurrent use and should not block.
+type Exporter interface {
+	Export(span SpanData)
+}
+
+// StdoutExporter writes each span as one JSON line, to stdout or any writer
+type StdoutExporter struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+// NewStdoutExporter creates an exporter writing JSON lines to w, e.g. os.Stdout
+func NewStdoutExporter(w io.Writer) *StdoutExporter {
+	return &StdoutExporter{enc: json.NewEncoder(w)}
+}
+
+// Export implements Exporter
+func (e *StdoutExporter) Export(span SpanData) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	_ = e.enc.Encode(span)
+}
+
+// InMemoryExporter keeps ended spans in memory, for tests and debugging
+type InMemoryExporter struct {
+	mu    sync.Mutex
+	spans []SpanData
+}
+
+// NewInMemoryExporter creates an empty in-memory exporter
+func NewInMemoryExporter() *InMemoryExporter {
+	return &InMemoryExporter{}
+}
+
+// Export implements Exporter
+func (e *InMemoryExporter) Export(span SpanData) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.spans = append(e.spans, span)
+}
+
+// Spans returns a copy of the spans exported so far, in end order
+func (e *InMemoryExporter) Spans() []SpanData {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return append([]SpanData(nil), e.spans...)
+}
+
+// Reset discards the recorded spans
+func (e *InMemoryExporter) Reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.spans = nil
+}
+
+var (
+	_ Exporter = (*StdoutExporter)(nil)
+	_ Exporter = (*InMemoryExporter)(nil)
+)
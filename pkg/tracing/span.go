@@ -0,0 +1,186 @@
+// Package tracing is a small OpenTelemetry-style tracer: spans are carried
+// in context.Context, propagated across services with the W3C traceparent
+// header and handed to a pluggable Exporter when they end.
+package tracing
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// TraceID identifies a trace
+type TraceID [16]byte
+
+// String returns the lowercase hex form used in traceparent
+func (t TraceID) String() string { return hex.EncodeToString(t[:]) }
+
+// IsValid reports whether t is not all zeroes
+func (t TraceID) IsValid() bool { return t != TraceID{} }
+
+// SpanID identifies a span within a trace
+type SpanID [8]byte
+
+// String returns the lowercase hex form used in traceparent
+func (s SpanID) String() string { return hex.EncodeToString(s[:]) }
+
+// IsValid reports whether s is not all zeroes
+func (s SpanID) IsValid() bool { return s != SpanID{} }
+
+// SpanContext is the part of a span that is propagated to children and other services
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Sampled bool
+}
+
+// IsValid reports whether both IDs are set
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID.IsValid() && sc.SpanID.IsValid()
+}
+
+// SpanKind describes the relationship of a span to its callers
+type SpanKind string
+
+const (
+	SpanKindInternal SpanKind = "internal"
+	SpanKindServer   SpanKind = "server"
+	SpanKindClient   SpanKind = "client"
+)
+
+// SpanData is the immutable record of an ended span, as passed to exporters
+type SpanData struct {
+	Name         string         `json:"name"`
+	Kind         SpanKind       `json:"kind"`
+	TraceID      string         `json:"trace_id"`
+	SpanID       string         `json:"span_id"`
+	ParentSpanID string         `json:"parent_span_id,omitempty"`
+	Start        time.Time      `json:"start"`
+	End          time.Time      `json:"end"`
+	Duration     time.Duration  `json:"duration"`
+	Attributes   map[string]any `json:"attributes,omitempty"`
+	Error        string         `json:"error,omitempty"`
+}
+
+// Span is a timed operation. A nil *Span is valid and does nothing, so
+// callers never need to check whether tracing is enabled.
+type Span struct {
+	tracer *Tracer
+	sc     SpanContext
+	parent SpanID
+	name   string
+	kind   SpanKind
+	start  time.Time
+
+	mu         sync.Mutex
+	attributes map[string]any
+	err        error
+	ended      bool
+}
+
+// SpanContext returns the identity of the span
+func (s *Span) SpanContext() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.sc
+}
+
+// SetAttribute records a key/value pair on the span
+func (s *Span) SetAttribute(key string, value any) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.attributes == nil {
+		s.attributes = make(map[string]any)
+	}
+	s.attributes[key] = value
+}
+
+// SetName renames the span, e.g. once the matched HTTP route is known
+func (s *Span) SetName(name string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.name = name
+}
+
+// RecordError marks the span as failed; a nil err is ignored
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.err = err
+}
+
+// End finishes the span and exports it if sampled. Only the first call has an effect.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+
+	end := time.Now()
+
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+
+	data := SpanData{
+		Name:       s.name,
+		Kind:       s.kind,
+		TraceID:    s.sc.TraceID.String(),
+		SpanID:     s.sc.SpanID.String(),
+		Start:      s.start,
+		End:        end,
+		Duration:   end.Sub(s.start),
+		Attributes: s.attributes,
+	}
+	if s.parent.IsValid() {
+		data.ParentSpanID = s.parent.String()
+	}
+	if s.err != nil {
+		data.Error = s.err.Error()
+	}
+	s.mu.Unlock()
+
+	if s.sc.Sampled && s.tracer != nil && s.tracer.exporter != nil {
+		s.tracer.exporter.Export(data)
+	}
+}
+
+// EndWithError records *errp, if any, and ends the span. Use it deferred
+// with a named error result:
+//
+//	ctx, span := tracing.Start(ctx, "UserService.GetUser")
+//	defer span.EndWithError(&err)
+func (s *Span) EndWithError(errp *error) {
+	if errp != nil {
+		s.RecordError(*errp)
+	}
+	s.End()
+}
+
+func newTraceID() TraceID {
+	var id TraceID
+	_, _ = rand.Read(id[:])
+	return id
+}
+
+func newSpanID() SpanID {
+	var id SpanID
+	_, _ = rand.Read(id[:])
+	return id
+}
@@ -0,0 +1,146 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+const (
+	validTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	validSpanID  = "00f067aa0ba902b7"
+)
+
+func TestParseTraceparentRoundTrip(t *testing.T) {
+	for _, value := range []string{
+		"00-" + validTraceID + "-" + validSpanID + "-01",
+		"00-" + validTraceID + "-" + validSpanID + "-00",
+	} {
+		sc, err := ParseTraceparent(value)
+		if err != nil {
+			t.Fatalf("ParseTraceparent(%q): %v", value, err)
+		}
+		if got := sc.Traceparent(); got != value {
+			t.Errorf("Traceparent() = %q, want %q", got, value)
+		}
+	}
+}
+
+func TestParseTraceparent(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		wantSampled bool
+		wantErr     bool
+	}{
+		{name: "sampled", value: "00-" + validTraceID + "-" + validSpanID + "-01", wantSampled: true},
+		{name: "unsampled", value: "00-" + validTraceID + "-" + validSpanID + "-00"},
+		{name: "other flags kept sampled bit", value: "00-" + validTraceID + "-" + validSpanID + "-03", wantSampled: true},
+		{name: "surrounding whitespace", value: " 00-" + validTraceID + "-" + validSpanID + "-01 ", wantSampled: true},
+		{name: "future version with extra fields", value: "01-" + validTraceID + "-" + validSpanID + "-01-extra", wantSampled: true},
+		{name: "empty", value: "", wantErr: true},
+		{name: "too few fields", value: "00-" + validTraceID + "-" + validSpanID, wantErr: true},
+		{name: "version 00 with extra fields", value: "00-" + validTraceID + "-" + validSpanID + "-01-extra", wantErr: true},
+		{name: "version ff", value: "ff-" + validTraceID + "-" + validSpanID + "-01", wantErr: true},
+		{name: "non-hex version", value: "zz-" + validTraceID + "-" + validSpanID + "-01", wantErr: true},
+		{name: "uppercase version", value: "0A-" + validTraceID + "-" + validSpanID + "-01", wantErr: true},
+		{name: "uppercase trace ID", value: "00-4BF92F3577B34DA6A3CE929D0E0E4736-" + validSpanID + "-01", wantErr: true},
+		{name: "uppercase span ID", value: "00-" + validTraceID + "-00F067AA0BA902B7-01", wantErr: true},
+		{name: "all-zero trace ID", value: "00-00000000000000000000000000000000-" + validSpanID + "-01", wantErr: true},
+		{name: "all-zero span ID", value: "00-" + validTraceID + "-0000000000000000-01", wantErr: true},
+		{name: "short trace ID", value: "00-" + validTraceID[:30] + "-" + validSpanID + "-01", wantErr: true},
+		{name: "non-hex span ID", value: "00-" + validTraceID + "-00f067aa0ba902bg-01", wantErr: true},
+		{name: "long flags", value: "00-" + validTraceID + "-" + validSpanID + "-001", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sc, err := ParseTraceparent(tt.value)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidTraceparent) {
+					t.Fatalf("ParseTraceparent(%q) = %+v, %v, want ErrInvalidTraceparent", tt.value, sc, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseTraceparent(%q): %v", tt.value, err)
+			}
+			if sc.TraceID.String() != validTraceID || sc.SpanID.String() != validSpanID || sc.Sampled != tt.wantSampled {
+				t.Fatalf("ParseTraceparent(%q) = %+v, want %s/%s sampled=%v", tt.value, sc, validTraceID, validSpanID, tt.wantSampled)
+			}
+		})
+	}
+}
+
+func TestStartInheritsTraceFromParent(t *testing.T) {
+	exporter := NewInMemoryExporter()
+	tracer := NewTracer(exporter)
+
+	ctx, root := tracer.Start(context.Background(), "root")
+	_, child := tracer.Start(ctx, "child")
+	child.End()
+	root.End()
+
+	if child.SpanContext().TraceID != root.SpanContext().TraceID {
+		t.Fatal("child span does not share its parent's trace ID")
+	}
+	if child.SpanContext().SpanID == root.SpanContext().SpanID {
+		t.Fatal("child span reuses its parent's span ID")
+	}
+
+	spans := exporter.Spans()
+	if len(spans) != 2 {
+		t.Fatalf("got %d exported spans, want 2", len(spans))
+	}
+	if spans[0].Name != "child" || spans[0].ParentSpanID != root.SpanContext().SpanID.String() {
+		t.Errorf("child span %+v does not point at its parent %s", spans[0], root.SpanContext().SpanID)
+	}
+	if spans[1].ParentSpanID != "" {
+		t.Errorf("root span has parent %q", spans[1].ParentSpanID)
+	}
+}
+
+func TestStartContinuesRemoteParent(t *testing.T) {
+	tests := []struct {
+		name       string
+		flags      string
+		wantExport bool
+	}{
+		{"sampled", "01", true},
+		{"unsampled", "00", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter := NewInMemoryExporter()
+			tracer := NewTracer(exporter)
+
+			remote, err := ParseTraceparent("00-" + validTraceID + "-" + validSpanID + "-" + tt.flags)
+			if err != nil {
+				t.Fatalf("ParseTraceparent: %v", err)
+			}
+			ctx := ContextWithRemoteSpanContext(context.Background(), remote)
+
+			ctx, server := tracer.Start(ctx, "server")
+			_, child := tracer.Start(ctx, "child")
+			child.End()
+			server.End()
+
+			if server.SpanContext().TraceID != remote.TraceID || child.SpanContext().TraceID != remote.TraceID {
+				t.Fatal("spans do not continue the remote trace")
+			}
+			if server.SpanContext().Sampled != remote.Sampled || child.SpanContext().Sampled != remote.Sampled {
+				t.Fatal("spans do not inherit the remote sampling decision")
+			}
+
+			spans := exporter.Spans()
+			if !tt.wantExport {
+				if len(spans) != 0 {
+					t.Fatalf("exported %d spans of an unsampled trace", len(spans))
+				}
+				return
+			}
+			if len(spans) != 2 || spans[1].ParentSpanID != validSpanID {
+				t.Fatalf("got spans %+v, want the server span parented to the remote span %s", spans, validSpanID)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package tracing
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// Tracer creates spans and hands them to its exporter when they end
+type Tracer struct {
+	exporter Exporter
+}
+
+// NewTracer creates a tracer exporting to exporter; a nil exporter records nothing
+func NewTracer(exporter Exporter) *Tracer {
+	return &Tracer{exporter: exporter}
+}
+
+// defaultTracer is used by Start; it exports nothing until SetDefault is called
+var defaultTracer atomic.Pointer[Tracer]
+
+func init() {
+	defaultTracer.Store(NewTracer(nil))
+}
+
+// SetDefault makes t the tracer used by Start
+func SetDefault(t *Tracer) {
+	if t == nil {
+		t = NewTracer(nil)
+	}
+	defaultTracer.Store(t)
+}
+
+// Default returns the tracer used by Start
+func Default() *Tracer {
+	return defaultTracer.Load()
+}
+
+// StartOption configures a new span
+type StartOption func(*Span)
+
+// WithSpanKind sets the span kind; spans are internal by default
+func WithSpanKind(kind SpanKind) StartOption {
+	return func(s *Span) {
+		s.kind = kind
+	}
+}
+
+// WithAttributes sets initial attributes as alternating keys and values
+func WithAttributes(keyValues ...any) StartOption {
+	return func(s *Span) {
+		for i := 0; i+1 < len(keyValues); i += 2 {
+			if key, ok := keyValues[i].(string); ok {
+				s.SetAttribute(key, keyValues[i+1])
+			}
+		}
+	}
+}
+
+// Start starts a span with the default tracer, see Tracer.Start
+func Start(ctx context.Context, name string, opts ...StartOption) (context.Context, *Span) {
+	return Default().Start(ctx, name, opts...)
+}
+
+// Start starts a span as a child of the span in ctx, or of the remote
+// parent set by ContextWithRemoteSpanContext, or as a new trace root. The
+// returned context carries the new span.
+func (t *Tracer) Start(ctx context.Context, name string, opts ...StartOption) (context.Context, *Span) {
+	span := &Span{
+		tracer: t,
+		name:   name,
+		kind:   SpanKindInternal,
+		start:  time.Now(),
+	}
+
+	parent := SpanContextFromContext(ctx)
+	if parent.IsValid() {
+		span.sc = SpanContext{TraceID: parent.TraceID, SpanID: newSpanID(), Sampled: parent.Sampled}
+		span.parent = parent.SpanID
+	} else {
+		span.sc = SpanContext{TraceID: newTraceID(), SpanID: newSpanID(), Sampled: true}
+	}
+
+	for _, opt := range opts {
+		opt(span)
+	}
+
+	return ContextWithSpan(ctx, span), span
+}
+
+type spanKey struct{}
+
+type remoteKey struct{}
+
+// ContextWithSpan returns ctx carrying span
+func ContextWithSpan(ctx context.Context, span *Span) context.Context {
+	return context.WithValue(ctx, spanKey{}, span)
+}
+
+// SpanFromContext returns the current span, or nil
+func SpanFromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(spanKey{}).(*Span)
+	return span
+}
+
+// ContextWithRemoteSpanContext returns ctx with a parent received from another service
+func ContextWithRemoteSpanContext(ctx context.Context, sc SpanContext) context.Context {
+	return context.WithValue(ctx, remoteKey{}, sc)
+}
+
+// SpanContextFromContext returns the span context of the current span, or
+// the remote parent when no local span is active
+func SpanContextFromContext(ctx context.Context) SpanContext {
+	if span := SpanFromContext(ctx); span != nil {
+		return span.SpanContext()
+	}
+	sc, _ := ctx.Value(remoteKey{}).(SpanContext)
+	return sc
+}
@@ -0,0 +1,8 @@
+package authPort
+
+import "github.com/gofiber/fiber/v2"
+
+// AuthMiddleware guards routes that require a valid session
+type AuthMiddleware interface {
+	RequireAuth(c *fiber.Ctx) error
+}
@@ -0,0 +1,16 @@
+package authPort
+
+import (
+	"context"
+
+	authModel "github.com/fbriansyah/go-modular/internal/model/auth"
+)
+
+type SessionRepository interface {
+	Create(ctx context.Context, session *authModel.Session) error
+	GetByID(ctx context.Context, id string) (*authModel.Session, error)
+	Delete(ctx context.Context, id string) error
+	DeleteByUserID(ctx context.Context, userID string) error
+	DeleteByUserIDExcept(ctx context.Context, userID, keepID string) error
+	DeleteExpired(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,17 @@
+package authPort
+
+import (
+	"context"
+
+	authModel "github.com/fbriansyah/go-modular/internal/model/auth"
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+)
+
+type AuthService interface {
+	Login(ctx context.Context, req *authModel.LoginRequest) (*authModel.LoginResponse, error)
+	Logout(ctx context.Context, token string) error
+	Authenticate(ctx context.Context, token string) (*authModel.Session, *userModel.User, error)
+	CleanupExpiredSessions(ctx context.Context) error
+	RevokeUserSessions(ctx context.Context, userID string) error
+	RevokeOtherUserSessions(ctx context.Context, userID, keepSessionID string) error
+}
@@ -0,0 +1,43 @@
+package userPort
+
+import (
+	"time"
+
+	userModel "github.com/fbriansyah/go-modular/internal/model/user"
+)
+
+// UserCreated is published after a user is created
+type UserCreated struct {
+	UserID     string    `json:"user_id"`
+	Email      string    `json:"email"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+func (UserCreated) EventName() string { return "user.created" }
+
+// UserUpdated is published after a user is updated. SessionID is the
+// session that made the change, when it was made over HTTP.
+type UserUpdated struct {
+	UserID          string               `json:"user_id"`
+	Status          userModel.UserStatus `json:"status"`
+	PreviousStatus  userModel.UserStatus `json:"previous_status"`
+	PasswordChanged bool                 `json:"password_changed"`
+	SessionID       string               `json:"session_id,omitempty"`
+	Version         int                  `json:"version"`
+	OccurredAt      time.Time            `json:"occurred_at"`
+}
+
+func (UserUpdated) EventName() string { return "user.updated" }
+
+// Deactivated reports whether the update took the user out of the active status
+func (e UserUpdated) Deactivated() bool {
+	return e.PreviousStatus == userModel.UserStatusActive && e.Status != userModel.UserStatusActive
+}
+
+// UserDeleted is published after a user is deleted
+type UserDeleted struct {
+	UserID     string    `json:"user_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+func (UserDeleted) EventName() string { return "user.deleted" }
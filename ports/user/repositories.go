@@ -10,4 +10,7 @@ import (
 type UserRepository interface {
 	database.Repository[userModel.User, string]
 	GetByEmail(ctx context.Context, email string) (*userModel.User, error)
+	Search(ctx context.Context, filter *userModel.UserFilter, limit, offset int) ([]*userModel.User, error)
+	CountFiltered(ctx context.Context, filter *userModel.UserFilter) (int64, error)
+	ListAfter(ctx context.Context, filter *userModel.UserFilter, afterID string, limit int) ([]*userModel.User, error)
 }
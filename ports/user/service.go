@@ -8,5 +8,11 @@ import (
 
 type UserService interface {
 	CreateUser(ctx context.Context, req *userModel.CreateUserRequest) (*userModel.User, error)
-	ListUser(ctx context.Context, query *userModel.ListUserQuery) ([]*userModel.User, error)
+	GetUser(ctx context.Context, id string) (*userModel.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*userModel.User, error)
+	UpdateUser(ctx context.Context, req *userModel.UpdateUserRequest) (*userModel.User, error)
+	UpdateUserStatus(ctx context.Context, req *userModel.UpdateUserStatusRequest) (*userModel.User, error)
+	DeleteUser(ctx context.Context, id string) error
+	ListUser(ctx context.Context, query *userModel.ListUserQuery) ([]*userModel.User, int64, error)
+	ListUserByCursor(ctx context.Context, query *userModel.ListUserQuery) ([]*userModel.User, string, error)
 }
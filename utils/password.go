@@ -1,41 +0,0 @@
-package utils
-
-import (
-	"errors"
-	"regexp"
-)
-
-// validatePassword validates password requirements
-func ValidatePassword(password string) error {
-	if password == "" {
-		return errors.New("password cannot be empty")
-	}
-
-	if len(password) < 8 {
-		return errors.New("password must be at least 8 characters long")
-	}
-
-	if len(password) > 128 {
-		return errors.New("password cannot exceed 128 characters")
-	}
-
-	// Check for at least one uppercase letter
-	hasUpper := regexp.MustCompile(`[A-Z]`).MatchString(password)
-	if !hasUpper {
-		return errors.New("password must contain at least one uppercase letter")
-	}
-
-	// Check for at least one lowercase letter
-	hasLower := regexp.MustCompile(`[a-z]`).MatchString(password)
-	if !hasLower {
-		return errors.New("password must contain at least one lowercase letter")
-	}
-
-	// Check for at least one digit
-	hasDigit := regexp.MustCompile(`\d`).MatchString(password)
-	if !hasDigit {
-		return errors.New("password must contain at least one digit")
-	}
-
-	return nil
-}